@@ -4,9 +4,11 @@ go 1.18
 
 require (
 	github.com/rs/zerolog v1.28.0
+	github.com/shopspring/decimal v1.3.1
 	golang.org/x/sys v0.4.0
 	google.golang.org/grpc v1.52.0
 	google.golang.org/protobuf v1.28.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
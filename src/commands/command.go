@@ -0,0 +1,169 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"time"
+)
+
+const timeLayout = "02.01.2006 15:04:05"
+
+// Command is a typed TXmlConnector command, Marshal validates it and renders
+// <command id="..."> with all values escaped.
+type Command interface {
+	CommandId() string
+	Validate() error
+}
+
+type ValidationError struct {
+	Command string
+	Field   string
+	Reason  string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("command %s: %s %s", e.Command, e.Field, e.Reason)
+}
+
+func invalid(command Command, field string, reason string) error {
+	return &ValidationError{Command: command.CommandId(), Field: field, Reason: reason}
+}
+
+func Marshal(command Command) (string, error) {
+	err := command.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	start := xml.StartElement{
+		Name: xml.Name{Local: "command"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "id"}, Value: command.CommandId()}},
+	}
+
+	buffer := bytes.Buffer{}
+	encoder := xml.NewEncoder(&buffer)
+	err = encoder.EncodeElement(command, start)
+	if err != nil {
+		return "", err
+	}
+
+	err = encoder.Flush()
+	if err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// Flag is rendered as an empty element when set and omitted otherwise (<bymarket/>).
+type Flag bool
+
+func (f Flag) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !f {
+		return nil
+	}
+
+	return e.EncodeElement("", start)
+}
+
+// Time is rendered in the connector format in Moscow time.
+type Time struct {
+	time.Time
+}
+
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.In(messages.MoscowLocation).Format(timeLayout)), nil
+}
+
+// Validity is the validafter/validbefore/validfor value: a date or one of the keywords.
+type Validity string
+
+const (
+	ValidNow          Validity = "0"
+	ValidTillCanceled Validity = "till_canceled"
+	ValidTillClose    Validity = "till_market_close"
+	ValidTillOpen     Validity = "till_market_open"
+)
+
+func ValidAt(t time.Time) Validity {
+	return Validity(t.In(messages.MoscowLocation).Format(timeLayout))
+}
+
+type SecurityRef struct {
+	Board   string `xml:"board"`
+	SecCode string `xml:"seccode"`
+}
+
+func (r SecurityRef) validate(command Command, field string) error {
+	if r.Board == "" {
+		return invalid(command, field+".board", "is required")
+	}
+	if r.SecCode == "" {
+		return invalid(command, field+".seccode", "is required")
+	}
+
+	return nil
+}
+
+// SecurityList is rendered as a group of <security> elements and omitted when empty.
+type SecurityList []SecurityRef
+
+func (l SecurityList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(l) == 0 {
+		return nil
+	}
+
+	return e.EncodeElement(struct {
+		Items []SecurityRef `xml:"security"`
+	}{Items: l}, start)
+}
+
+func (l *SecurityList) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	list := struct {
+		Items []SecurityRef `xml:"security"`
+	}{}
+
+	err := d.DecodeElement(&list, &start)
+	if err != nil {
+		return err
+	}
+
+	*l = append(*l, list.Items...)
+
+	return nil
+}
+
+type MarketSecurityRef struct {
+	Market  int    `xml:"market"`
+	SecCode string `xml:"seccode"`
+}
+
+func (r MarketSecurityRef) validate(command Command, field string) error {
+	if r.SecCode == "" {
+		return invalid(command, field+".seccode", "is required")
+	}
+
+	return nil
+}
+
+func validateBuySell(command Command, value messages.BuySell) error {
+	if value != messages.Buy && value != messages.Sell {
+		return invalid(command, "buysell", "must be B or S")
+	}
+
+	return nil
+}
+
+// validateAccount checks that the order is addressed to exactly one of client and union.
+func validateAccount(command Command, client string, union string) error {
+	if client == "" && union == "" {
+		return invalid(command, "client", "or union is required")
+	}
+	if client != "" && union != "" {
+		return invalid(command, "client", "and union are mutually exclusive")
+	}
+
+	return nil
+}
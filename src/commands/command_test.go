@@ -0,0 +1,220 @@
+package commands
+
+import (
+	"errors"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/shopspring/decimal"
+	"testing"
+	"time"
+)
+
+func TestMarshal(t *testing.T) {
+	autoPos := true
+	price := decimal.RequireFromString("250.10")
+
+	tests := []struct {
+		name    string
+		command Command
+		want    string
+	}{
+		{
+			name:    "connect",
+			command: Connect{Login: "login", Password: "password", Host: "tr1.finam.ru", Port: 3900},
+			want: `<command id="connect"><login>login</login><password>password</password>` +
+				`<host>tr1.finam.ru</host><port>3900</port></command>`,
+		},
+		{
+			name: "connect escaped",
+			command: Connect{
+				Login:    "a&b",
+				Password: `<"pass'>`,
+				Host:     "host",
+				Port:     3900,
+				AutoPos:  &autoPos,
+				Proxy:    &Proxy{Type: ProxySocks5, Addr: "proxy", Port: 1080, Password: `p"&`},
+			},
+			want: `<command id="connect"><login>a&amp;b</login><password>&lt;&#34;pass&#39;&gt;</password>` +
+				`<host>host</host><port>3900</port><autopos>true</autopos>` +
+				`<proxy type="SOCKS5" addr="proxy" port="1080" password="p&#34;&amp;"></proxy></command>`,
+		},
+		{
+			name:    "disconnect",
+			command: Disconnect{},
+			want:    `<command id="disconnect"></command>`,
+		},
+		{
+			name: "neworder",
+			command: NewOrder{
+				Security:  SecurityRef{Board: "TQBR", SecCode: "SBER"},
+				Client:    "C1",
+				Price:     &price,
+				Quantity:  2,
+				BuySell:   messages.Buy,
+				BrokerRef: "</brokerref><bymarket/>",
+				Unfilled:  UnfilledFOK,
+				ExpDate:   &Time{time.Date(2024, 2, 21, 7, 30, 0, 0, time.UTC)},
+			},
+			want: `<command id="neworder"><security><board>TQBR</board><seccode>SBER</seccode></security>` +
+				`<client>C1</client><price>250.1</price><quantity>2</quantity><buysell>B</buysell>` +
+				`<brokerref>&lt;/brokerref&gt;&lt;bymarket/&gt;</brokerref><unfilled>FOK</unfilled>` +
+				`<expdate>21.02.2024 10:30:00</expdate></command>`,
+		},
+		{
+			name: "neworder by market",
+			command: NewOrder{
+				Security: SecurityRef{Board: "TQBR", SecCode: "SBER"},
+				Union:    "U1",
+				Quantity: 1,
+				BuySell:  messages.Sell,
+				ByMarket: true,
+				NoSplit:  true,
+			},
+			want: `<command id="neworder"><security><board>TQBR</board><seccode>SBER</seccode></security>` +
+				`<union>U1</union><quantity>1</quantity><buysell>S</buysell><bymarket></bymarket>` +
+				`<nosplit></nosplit></command>`,
+		},
+		{
+			name: "subscribe",
+			command: Subscribe{
+				Quotations: SecurityList{{Board: "TQBR", SecCode: "SBER"}, {Board: "TQBR", SecCode: "GAZP"}},
+				Quotes:     SecurityList{{Board: "FUT", SecCode: "Si&Z6"}},
+			},
+			want: `<command id="subscribe"><quotations>` +
+				`<security><board>TQBR</board><seccode>SBER</seccode></security>` +
+				`<security><board>TQBR</board><seccode>GAZP</seccode></security></quotations>` +
+				`<quotes><security><board>FUT</board><seccode>Si&amp;Z6</seccode></security></quotes></command>`,
+		},
+		{
+			name:    "unsubscribe",
+			command: Unsubscribe{AllTrades: SecurityList{{Board: "TQBR", SecCode: "SBER"}}},
+			want: `<command id="unsubscribe"><alltrades>` +
+				`<security><board>TQBR</board><seccode>SBER</seccode></security></alltrades></command>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Marshal(test.command)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Fatalf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMarshalValidation(t *testing.T) {
+	price := decimal.RequireFromString("250.10")
+	sber := SecurityRef{Board: "TQBR", SecCode: "SBER"}
+	connect := Connect{Login: "login", Password: "password", Host: "host", Port: 3900}
+	order := NewOrder{Security: sber, Client: "C1", Price: &price, Quantity: 2, BuySell: messages.Buy}
+
+	tests := []struct {
+		name    string
+		command Command
+		// the error text
+		want string
+	}{
+		{
+			name:    "connect without login",
+			command: func() Command { c := connect; c.Login = ""; return c }(),
+			want:    "command connect: login is required",
+		},
+		{
+			name:    "connect port",
+			command: func() Command { c := connect; c.Port = 70000; return c }(),
+			want:    "command connect: port must be in range 1-65535",
+		},
+		{
+			name:    "connect language",
+			command: func() Command { c := connect; c.Language = "de"; return c }(),
+			want:    "command connect: language must be ru or en",
+		},
+		{
+			name:    "connect negative timeout",
+			command: func() Command { c := connect; c.SessionTimeout = -1; return c }(),
+			want:    "command connect: timeouts must not be negative",
+		},
+		{
+			name:    "connect proxy type",
+			command: func() Command { c := connect; c.Proxy = &Proxy{Type: "SOCKS6", Addr: "proxy", Port: 1080}; return c }(),
+			want:    "command connect: proxy.type must be SOCKS4, SOCKS5 or HTTP-CONNECT",
+		},
+		{
+			name:    "connect proxy port",
+			command: func() Command { c := connect; c.Proxy = &Proxy{Type: ProxySocks4, Addr: "proxy"}; return c }(),
+			want:    "command connect: proxy.port must be in range 1-65535",
+		},
+		{
+			name:    "neworder seccode",
+			command: func() Command { c := order; c.Security.SecCode = ""; return c }(),
+			want:    "command neworder: security.seccode is required",
+		},
+		{
+			name:    "neworder without account",
+			command: func() Command { c := order; c.Client = ""; return c }(),
+			want:    "command neworder: client or union is required",
+		},
+		{
+			name:    "neworder both accounts",
+			command: func() Command { c := order; c.Union = "U1"; return c }(),
+			want:    "command neworder: client and union are mutually exclusive",
+		},
+		{
+			name:    "neworder buysell",
+			command: func() Command { c := order; c.BuySell = "X"; return c }(),
+			want:    "command neworder: buysell must be B or S",
+		},
+		{
+			name:    "neworder quantity",
+			command: func() Command { c := order; c.Quantity = 0; return c }(),
+			want:    "command neworder: quantity must be positive",
+		},
+		{
+			name:    "neworder hidden",
+			command: func() Command { c := order; c.Hidden = 3; return c }(),
+			want:    "command neworder: hidden must be in range 0-quantity",
+		},
+		{
+			name:    "neworder without price",
+			command: func() Command { c := order; c.Price = nil; return c }(),
+			want:    "command neworder: price is required without bymarket",
+		},
+		{
+			name:    "neworder unfilled",
+			command: func() Command { c := order; c.Unfilled = "GTC"; return c }(),
+			want:    "command neworder: unfilled must be PutInQueue, FOK or IOC",
+		},
+		{
+			name:    "subscribe nothing",
+			command: Subscribe{},
+			want:    "command subscribe: security at least one is required",
+		},
+		{
+			name:    "subscribe board",
+			command: Subscribe{Quotes: SecurityList{sber, {SecCode: "GAZP"}}},
+			want:    "command subscribe: quotes.board is required",
+		},
+		{
+			name:    "unsubscribe seccode",
+			command: Unsubscribe{AllTrades: SecurityList{{Board: "TQBR"}}},
+			want:    "command unsubscribe: alltrades.seccode is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Marshal(test.command)
+
+			var validationError *ValidationError
+			if !errors.As(err, &validationError) || validationError.Command != test.command.CommandId() {
+				t.Fatalf("got %q with %v, want a validation error", got, err)
+			}
+			if err.Error() != test.want {
+				t.Fatalf("got %q, want %q", err.Error(), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package commands
+
+type GetSecurities struct{}
+
+func (c GetSecurities) CommandId() string {
+	return "get_securities"
+}
+
+func (c GetSecurities) Validate() error {
+	return nil
+}
+
+type GetMarkets struct{}
+
+func (c GetMarkets) CommandId() string {
+	return "get_markets"
+}
+
+func (c GetMarkets) Validate() error {
+	return nil
+}
+
+type GetSecuritiesInfo struct {
+	Securities []MarketSecurityRef `xml:"security"`
+}
+
+func (c GetSecuritiesInfo) CommandId() string {
+	return "get_securities_info"
+}
+
+func (c GetSecuritiesInfo) Validate() error {
+	if len(c.Securities) == 0 {
+		return invalid(c, "security", "at least one is required")
+	}
+
+	for _, security := range c.Securities {
+		err := security.validate(c, "security")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type Subscribe struct {
+	AllTrades  SecurityList `xml:"alltrades"`
+	Quotations SecurityList `xml:"quotations"`
+	Quotes     SecurityList `xml:"quotes"`
+}
+
+func (c Subscribe) CommandId() string {
+	return "subscribe"
+}
+
+func (c Subscribe) Validate() error {
+	return validateSubscription(c, c.AllTrades, c.Quotations, c.Quotes)
+}
+
+type Unsubscribe Subscribe
+
+func (c Unsubscribe) CommandId() string {
+	return "unsubscribe"
+}
+
+func (c Unsubscribe) Validate() error {
+	return validateSubscription(c, c.AllTrades, c.Quotations, c.Quotes)
+}
+
+func validateSubscription(command Command, allTrades SecurityList, quotations SecurityList, quotes SecurityList) error {
+	if len(allTrades)+len(quotations)+len(quotes) == 0 {
+		return invalid(command, "security", "at least one is required")
+	}
+
+	fields := []string{"alltrades", "quotations", "quotes"}
+	for i, securities := range []SecurityList{allTrades, quotations, quotes} {
+		for _, security := range securities {
+			err := security.validate(command, fields[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type TickSecurity struct {
+	SecId int `xml:"secid,attr"`
+	// ticks are sent starting after this trade number, 0 - from the beginning of the session
+	TradeNo int64 `xml:"tradeno,attr"`
+}
+
+type SubscribeTicks struct {
+	Securities []TickSecurity `xml:"security"`
+	Filter     *bool          `xml:"filter,omitempty"`
+}
+
+func (c SubscribeTicks) CommandId() string {
+	return "subscribe_ticks"
+}
+
+func (c SubscribeTicks) Validate() error {
+	if len(c.Securities) == 0 {
+		return invalid(c, "security", "is required")
+	}
+
+	for _, security := range c.Securities {
+		if security.SecId <= 0 {
+			return invalid(c, "security.secid", "must be positive")
+		}
+		if security.TradeNo < 0 {
+			return invalid(c, "security.tradeno", "must not be negative")
+		}
+	}
+
+	return nil
+}
+
+type GetHistoryData struct {
+	Security SecurityRef `xml:"security"`
+	// candle kind id from candlekinds
+	Period int  `xml:"period"`
+	Count  int  `xml:"count"`
+	Reset  bool `xml:"reset"`
+}
+
+func (c GetHistoryData) CommandId() string {
+	return "gethistorydata"
+}
+
+func (c GetHistoryData) Validate() error {
+	err := c.Security.validate(c, "security")
+	if err != nil {
+		return err
+	}
+	if c.Period <= 0 {
+		return invalid(c, "period", "must be positive")
+	}
+	if c.Count <= 0 {
+		return invalid(c, "count", "must be positive")
+	}
+
+	return nil
+}
+
+type GetOldNews struct {
+	Count int `xml:"count,attr"`
+}
+
+func (c GetOldNews) CommandId() string {
+	return "get_old_news"
+}
+
+func (c GetOldNews) Validate() error {
+	if c.Count <= 0 {
+		return invalid(c, "count", "must be positive")
+	}
+
+	return nil
+}
+
+type GetNewsBody struct {
+	NewsId int64 `xml:"news_id,attr"`
+}
+
+func (c GetNewsBody) CommandId() string {
+	return "get_news_body"
+}
+
+func (c GetNewsBody) Validate() error {
+	if c.NewsId <= 0 {
+		return invalid(c, "news_id", "must be positive")
+	}
+
+	return nil
+}
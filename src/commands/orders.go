@@ -0,0 +1,305 @@
+package commands
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/shopspring/decimal"
+)
+
+type Unfilled string
+
+const (
+	UnfilledPutInQueue Unfilled = "PutInQueue"
+	UnfilledFOK        Unfilled = "FOK"
+	UnfilledIOC        Unfilled = "IOC"
+)
+
+type CondType string
+
+const (
+	CondBid       CondType = "Bid"
+	CondBidOrLast CondType = "BidOrLast"
+	CondAsk       CondType = "Ask"
+	CondAskOrLast CondType = "AskOrLast"
+	CondTime      CondType = "Time"
+	CondCovDown   CondType = "CovDown"
+	CondCovUp     CondType = "CovUp"
+	CondLastUp    CondType = "LastUp"
+	CondLastDown  CondType = "LastDown"
+)
+
+type MoveFlag int
+
+const (
+	// keep the quantity of the original order
+	MoveKeepQuantity MoveFlag = 0
+	// set the quantity from the command
+	MoveSetQuantity MoveFlag = 1
+	// set the quantity from the command if the original order is not partially filled
+	MoveSetQuantityIfUnfilled MoveFlag = 2
+)
+
+type NewOrder struct {
+	Security  SecurityRef      `xml:"security"`
+	Client    string           `xml:"client,omitempty"`
+	Union     string           `xml:"union,omitempty"`
+	Price     *decimal.Decimal `xml:"price,omitempty"`
+	Hidden    int64            `xml:"hidden,omitempty"`
+	Quantity  int64            `xml:"quantity"`
+	BuySell   messages.BuySell `xml:"buysell"`
+	ByMarket  Flag             `xml:"bymarket"`
+	BrokerRef string           `xml:"brokerref,omitempty"`
+	Unfilled  Unfilled         `xml:"unfilled,omitempty"`
+	UseCredit Flag             `xml:"usecredit"`
+	NoSplit   Flag             `xml:"nosplit"`
+	ExpDate   *Time            `xml:"expdate,omitempty"`
+}
+
+func (c NewOrder) CommandId() string {
+	return "neworder"
+}
+
+func (c NewOrder) Validate() error {
+	err := validateOrder(c, c.Security, c.Client, c.Union, c.Price, bool(c.ByMarket), c.Hidden, c.Quantity, c.BuySell)
+	if err != nil {
+		return err
+	}
+
+	switch c.Unfilled {
+	case "", UnfilledPutInQueue, UnfilledFOK, UnfilledIOC:
+	default:
+		return invalid(c, "unfilled", "must be PutInQueue, FOK or IOC")
+	}
+
+	return nil
+}
+
+type NewCondOrder struct {
+	Security    SecurityRef      `xml:"security"`
+	Client      string           `xml:"client,omitempty"`
+	Union       string           `xml:"union,omitempty"`
+	Price       *decimal.Decimal `xml:"price,omitempty"`
+	Hidden      int64            `xml:"hidden,omitempty"`
+	Quantity    int64            `xml:"quantity"`
+	BuySell     messages.BuySell `xml:"buysell"`
+	ByMarket    Flag             `xml:"bymarket"`
+	BrokerRef   string           `xml:"brokerref,omitempty"`
+	CondType    CondType         `xml:"cond_type"`
+	CondValue   string           `xml:"cond_value"`
+	ValidAfter  Validity         `xml:"validafter,omitempty"`
+	ValidBefore Validity         `xml:"validbefore,omitempty"`
+	UseCredit   Flag             `xml:"usecredit"`
+	NoSplit     Flag             `xml:"nosplit"`
+	ExpDate     *Time            `xml:"expdate,omitempty"`
+	WithinPos   Flag             `xml:"within_pos"`
+}
+
+func (c NewCondOrder) CommandId() string {
+	return "newcondorder"
+}
+
+func (c NewCondOrder) Validate() error {
+	err := validateOrder(c, c.Security, c.Client, c.Union, c.Price, bool(c.ByMarket), c.Hidden, c.Quantity, c.BuySell)
+	if err != nil {
+		return err
+	}
+
+	switch c.CondType {
+	case CondBid, CondBidOrLast, CondAsk, CondAskOrLast, CondTime, CondCovDown, CondCovUp, CondLastUp, CondLastDown:
+	default:
+		return invalid(c, "cond_type", "is unknown")
+	}
+
+	if c.CondValue == "" {
+		return invalid(c, "cond_value", "is required")
+	}
+	if c.ValidBefore == ValidNow {
+		return invalid(c, "validbefore", "must be a date or a keyword other than 0")
+	}
+
+	return nil
+}
+
+type NewStopOrder struct {
+	Security      SecurityRef      `xml:"security"`
+	Client        string           `xml:"client,omitempty"`
+	Union         string           `xml:"union,omitempty"`
+	BuySell       messages.BuySell `xml:"buysell"`
+	LinkedOrderNo int64            `xml:"linkedorderno,omitempty"`
+	ValidFor      Validity         `xml:"validfor,omitempty"`
+	ExpDate       *Time            `xml:"expdate,omitempty"`
+	StopLoss      *StopLoss        `xml:"stoploss,omitempty"`
+	TakeProfit    *TakeProfit      `xml:"takeprofit,omitempty"`
+}
+
+type StopLoss struct {
+	ActivationPrice decimal.Decimal  `xml:"activationprice"`
+	OrderPrice      *decimal.Decimal `xml:"orderprice,omitempty"`
+	ByMarket        Flag             `xml:"bymarket"`
+	Quantity        int64            `xml:"quantity"`
+	UseCredit       Flag             `xml:"usecredit"`
+	// minutes
+	GuardTime int    `xml:"guardtime,omitempty"`
+	BrokerRef string `xml:"brokerref,omitempty"`
+}
+
+type TakeProfit struct {
+	ActivationPrice decimal.Decimal  `xml:"activationprice"`
+	Quantity        int64            `xml:"quantity"`
+	UseCredit       Flag             `xml:"usecredit"`
+	GuardTime       int              `xml:"guardtime,omitempty"`
+	BrokerRef       string           `xml:"brokerref,omitempty"`
+	Correction      *decimal.Decimal `xml:"correction,omitempty"`
+	Spread          *decimal.Decimal `xml:"spread,omitempty"`
+	ByMarket        Flag             `xml:"bymarket"`
+}
+
+func (c NewStopOrder) CommandId() string {
+	return "newstoporder"
+}
+
+func (c NewStopOrder) Validate() error {
+	err := c.Security.validate(c, "security")
+	if err != nil {
+		return err
+	}
+
+	err = validateAccount(c, c.Client, c.Union)
+	if err != nil {
+		return err
+	}
+
+	err = validateBuySell(c, c.BuySell)
+	if err != nil {
+		return err
+	}
+
+	if c.StopLoss == nil && c.TakeProfit == nil {
+		return invalid(c, "stoploss", "or takeprofit is required")
+	}
+
+	if c.StopLoss != nil {
+		if !c.StopLoss.ActivationPrice.IsPositive() {
+			return invalid(c, "stoploss.activationprice", "must be positive")
+		}
+		if c.StopLoss.Quantity <= 0 {
+			return invalid(c, "stoploss.quantity", "must be positive")
+		}
+		if !bool(c.StopLoss.ByMarket) && (c.StopLoss.OrderPrice == nil || !c.StopLoss.OrderPrice.IsPositive()) {
+			return invalid(c, "stoploss.orderprice", "is required without bymarket")
+		}
+	}
+
+	if c.TakeProfit != nil {
+		if !c.TakeProfit.ActivationPrice.IsPositive() {
+			return invalid(c, "takeprofit.activationprice", "must be positive")
+		}
+		if c.TakeProfit.Quantity <= 0 {
+			return invalid(c, "takeprofit.quantity", "must be positive")
+		}
+	}
+
+	return nil
+}
+
+type CancelOrder struct {
+	TransactionId int64 `xml:"transactionid"`
+}
+
+func (c CancelOrder) CommandId() string {
+	return "cancelorder"
+}
+
+func (c CancelOrder) Validate() error {
+	if c.TransactionId <= 0 {
+		return invalid(c, "transactionid", "must be positive")
+	}
+
+	return nil
+}
+
+type CancelStopOrder struct {
+	TransactionId int64 `xml:"transactionid"`
+}
+
+func (c CancelStopOrder) CommandId() string {
+	return "cancelstoporder"
+}
+
+func (c CancelStopOrder) Validate() error {
+	if c.TransactionId <= 0 {
+		return invalid(c, "transactionid", "must be positive")
+	}
+
+	return nil
+}
+
+type MoveOrder struct {
+	TransactionId int64           `xml:"transactionid"`
+	Price         decimal.Decimal `xml:"price"`
+	MoveFlag      MoveFlag        `xml:"moveflag"`
+	Quantity      int64           `xml:"quantity"`
+}
+
+func (c MoveOrder) CommandId() string {
+	return "moveorder"
+}
+
+func (c MoveOrder) Validate() error {
+	if c.TransactionId <= 0 {
+		return invalid(c, "transactionid", "must be positive")
+	}
+	if !c.Price.IsPositive() {
+		return invalid(c, "price", "must be positive")
+	}
+
+	switch c.MoveFlag {
+	case MoveKeepQuantity:
+	case MoveSetQuantity, MoveSetQuantityIfUnfilled:
+		if c.Quantity <= 0 {
+			return invalid(c, "quantity", "must be positive when moveflag changes it")
+		}
+	default:
+		return invalid(c, "moveflag", "must be 0, 1 or 2")
+	}
+
+	return nil
+}
+
+func validateOrder(
+	command Command,
+	security SecurityRef,
+	client string,
+	union string,
+	price *decimal.Decimal,
+	byMarket bool,
+	hidden int64,
+	quantity int64,
+	buySell messages.BuySell,
+) error {
+	err := security.validate(command, "security")
+	if err != nil {
+		return err
+	}
+
+	err = validateAccount(command, client, union)
+	if err != nil {
+		return err
+	}
+
+	err = validateBuySell(command, buySell)
+	if err != nil {
+		return err
+	}
+
+	if quantity <= 0 {
+		return invalid(command, "quantity", "must be positive")
+	}
+	if hidden < 0 || hidden > quantity {
+		return invalid(command, "hidden", "must be in range 0-quantity")
+	}
+	if !byMarket && (price == nil || !price.IsPositive()) {
+		return invalid(command, "price", "is required without bymarket")
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package commands
+
+type GetFortsPositions struct {
+	Client string `xml:"client,attr,omitempty"`
+}
+
+func (c GetFortsPositions) CommandId() string {
+	return "get_forts_positions"
+}
+
+func (c GetFortsPositions) Validate() error {
+	return nil
+}
+
+type GetClientLimits struct {
+	Client string `xml:"client,attr"`
+}
+
+func (c GetClientLimits) CommandId() string {
+	return "get_client_limits"
+}
+
+func (c GetClientLimits) Validate() error {
+	if c.Client == "" {
+		return invalid(c, "client", "is required")
+	}
+
+	return nil
+}
+
+type GetPortfolio struct {
+	Client string `xml:"client,attr"`
+}
+
+func (c GetPortfolio) CommandId() string {
+	return "get_portfolio"
+}
+
+func (c GetPortfolio) Validate() error {
+	if c.Client == "" {
+		return invalid(c, "client", "is required")
+	}
+
+	return nil
+}
+
+type GetMaxBuySell struct {
+	Client     string              `xml:"client,attr,omitempty"`
+	Union      string              `xml:"union,attr,omitempty"`
+	Securities []MarketSecurityRef `xml:"security"`
+}
+
+func (c GetMaxBuySell) CommandId() string {
+	return "get_max_buy_sell"
+}
+
+func (c GetMaxBuySell) Validate() error {
+	err := validateAccount(c, c.Client, c.Union)
+	if err != nil {
+		return err
+	}
+
+	if len(c.Securities) == 0 {
+		return invalid(c, "security", "at least one is required")
+	}
+
+	for _, security := range c.Securities {
+		err = security.validate(c, "security")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type GetUnitedEquity struct {
+	Union string `xml:"union,attr"`
+}
+
+func (c GetUnitedEquity) CommandId() string {
+	return "get_united_equity"
+}
+
+func (c GetUnitedEquity) Validate() error {
+	if c.Union == "" {
+		return invalid(c, "union", "is required")
+	}
+
+	return nil
+}
+
+type GetUnitedGo struct {
+	Union string `xml:"union,attr"`
+}
+
+func (c GetUnitedGo) CommandId() string {
+	return "get_united_go"
+}
+
+func (c GetUnitedGo) Validate() error {
+	if c.Union == "" {
+		return invalid(c, "union", "is required")
+	}
+
+	return nil
+}
+
+type GetUnitedPortfolio struct {
+	Client string `xml:"client,attr,omitempty"`
+	Union  string `xml:"union,attr,omitempty"`
+}
+
+func (c GetUnitedPortfolio) CommandId() string {
+	return "get_united_portfolio"
+}
+
+func (c GetUnitedPortfolio) Validate() error {
+	return validateAccount(c, c.Client, c.Union)
+}
+
+type GetMcPortfolio struct {
+	Client    string `xml:"client,attr,omitempty"`
+	Union     string `xml:"union,attr,omitempty"`
+	Currency  bool   `xml:"currency,attr"`
+	Asset     bool   `xml:"asset,attr"`
+	Money     bool   `xml:"money,attr"`
+	Depo      bool   `xml:"depo,attr"`
+	Registers bool   `xml:"registers,attr"`
+	MaxBs     bool   `xml:"maxbs,attr"`
+}
+
+func (c GetMcPortfolio) CommandId() string {
+	return "get_mc_portfolio"
+}
+
+func (c GetMcPortfolio) Validate() error {
+	return validateAccount(c, c.Client, c.Union)
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"strings"
+)
+
+// Result is the synchronous answer of SendCommand: <result success="..."/> or <error>.
+type Result struct {
+	Success       bool
+	TransactionId int64
+	Message       string
+	// the connector answered with <error> instead of <result>, usually a malformed command
+	ErrorResponse bool
+	Raw           string
+	// windows error code of the dll call, kept for diagnostics
+	Errno uint64
+}
+
+type resultXml struct {
+	XMLName       xml.Name `xml:"result"`
+	Success       bool     `xml:"success,attr"`
+	TransactionId int64    `xml:"transactionid,attr"`
+	Message       string   `xml:"message"`
+}
+
+var ErrUnknownResponse = errors.New("unknown command response")
+
+type ResultError struct {
+	Result *Result
+}
+
+func (e *ResultError) Error() string {
+	if e.Result.Message == "" {
+		return "command failed"
+	}
+
+	return e.Result.Message
+}
+
+func ParseResult(data string, errno uint64) (*Result, error) {
+	result := &Result{Raw: data, Errno: errno}
+
+	switch messages.RootOf(data) {
+	case "result":
+		parsed := resultXml{}
+		err := xml.Unmarshal([]byte(data), &parsed)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownResponse, err.Error())
+		}
+
+		result.Success = parsed.Success
+		result.TransactionId = parsed.TransactionId
+		result.Message = strings.TrimSpace(parsed.Message)
+
+	case messages.RootError:
+		parsed := messages.Error{}
+		err := xml.Unmarshal([]byte(data), &parsed)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownResponse, err.Error())
+		}
+
+		result.ErrorResponse = true
+		result.Message = strings.TrimSpace(parsed.Text)
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownResponse, data)
+	}
+
+	return result, nil
+}
+
+// Err returns ResultError for unsuccessful results.
+func (r *Result) Err() error {
+	if r.Success {
+		return nil
+	}
+
+	return &ResultError{Result: r}
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Result
+		// the error of Err
+		err string
+	}{
+		{
+			name: "success",
+			data: `<result success="true"/>`,
+			want: Result{Success: true},
+		},
+		{
+			name: "order accepted",
+			data: `<result success="true" transactionid="12"/>`,
+			want: Result{Success: true, TransactionId: 12},
+		},
+		{
+			name: "rejected",
+			data: `<result success="false"><message> Not enough funds </message></result>`,
+			want: Result{Message: "Not enough funds"},
+			err:  "Not enough funds",
+		},
+		{
+			name: "rejected without a message",
+			data: `<result success="false"/>`,
+			err:  "command failed",
+		},
+		{
+			name: "error response",
+			data: `<error> Wrong command syntax </error>`,
+			want: Result{ErrorResponse: true, Message: "Wrong command syntax"},
+			err:  "Wrong command syntax",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseResult(test.data, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.want.Raw = test.data
+			test.want.Errno = 5
+			if *got != test.want {
+				t.Fatalf("got %+v, want %+v", *got, test.want)
+			}
+
+			err = got.Err()
+			if test.err == "" {
+				if err != nil {
+					t.Fatalf("got %v for a successful result", err)
+				}
+				return
+			}
+
+			var resultError *ResultError
+			if !errors.As(err, &resultError) || resultError.Result != got || err.Error() != test.err {
+				t.Fatalf("got %v, want ResultError %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestParseResultUnknown(t *testing.T) {
+	for _, data := range []string{
+		"",
+		`<server_status connected="true"/>`,
+		`<result success="yes"/>`,
+		`<result success="true"`,
+		`<error><text></error>`,
+	} {
+		_, err := ParseResult(data, 0)
+		if !errors.Is(err, ErrUnknownResponse) {
+			t.Errorf("ParseResult(%q) got %v, want %v", data, err, ErrUnknownResponse)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package commands
+
+type ProxyType string
+
+const (
+	ProxySocks4      ProxyType = "SOCKS4"
+	ProxySocks5      ProxyType = "SOCKS5"
+	ProxyHttpConnect ProxyType = "HTTP-CONNECT"
+)
+
+type Connect struct {
+	Login          string `xml:"login"`
+	Password       string `xml:"password"`
+	Host           string `xml:"host"`
+	Port           int    `xml:"port"`
+	Language       string `xml:"language,omitempty"`
+	AutoPos        *bool  `xml:"autopos,omitempty"`
+	MicexRegisters *bool  `xml:"micex_registers,omitempty"`
+	Milliseconds   *bool  `xml:"milliseconds,omitempty"`
+	UtcTime        *bool  `xml:"utc_time,omitempty"`
+	Proxy          *Proxy `xml:"proxy,omitempty"`
+	// delay between requests to the server in milliseconds
+	RqDelay int `xml:"rqdelay,omitempty"`
+	// seconds
+	SessionTimeout int `xml:"session_timeout,omitempty"`
+	// seconds
+	RequestTimeout int `xml:"request_timeout,omitempty"`
+	// seconds, 0 - not pushed
+	PushULimits int `xml:"push_u_limits,omitempty"`
+	// seconds, 0 - not pushed
+	PushPosEquity int `xml:"push_pos_equity,omitempty"`
+}
+
+type Proxy struct {
+	Type     ProxyType `xml:"type,attr"`
+	Addr     string    `xml:"addr,attr"`
+	Port     int       `xml:"port,attr"`
+	Login    string    `xml:"login,attr,omitempty"`
+	Password string    `xml:"password,attr,omitempty"`
+}
+
+func (c Connect) CommandId() string {
+	return "connect"
+}
+
+func (c Connect) Validate() error {
+	if c.Login == "" {
+		return invalid(c, "login", "is required")
+	}
+	if c.Password == "" {
+		return invalid(c, "password", "is required")
+	}
+	if c.Host == "" {
+		return invalid(c, "host", "is required")
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return invalid(c, "port", "must be in range 1-65535")
+	}
+	if c.Language != "" && c.Language != "ru" && c.Language != "en" {
+		return invalid(c, "language", "must be ru or en")
+	}
+	if c.RqDelay < 0 || c.SessionTimeout < 0 || c.RequestTimeout < 0 || c.PushULimits < 0 || c.PushPosEquity < 0 {
+		return invalid(c, "timeouts", "must not be negative")
+	}
+
+	if c.Proxy != nil {
+		switch c.Proxy.Type {
+		case ProxySocks4, ProxySocks5, ProxyHttpConnect:
+		default:
+			return invalid(c, "proxy.type", "must be SOCKS4, SOCKS5 or HTTP-CONNECT")
+		}
+		if c.Proxy.Addr == "" {
+			return invalid(c, "proxy.addr", "is required")
+		}
+		if c.Proxy.Port <= 0 || c.Proxy.Port > 65535 {
+			return invalid(c, "proxy.port", "must be in range 1-65535")
+		}
+	}
+
+	return nil
+}
+
+type Disconnect struct{}
+
+func (c Disconnect) CommandId() string {
+	return "disconnect"
+}
+
+func (c Disconnect) Validate() error {
+	return nil
+}
+
+type ServerStatus struct{}
+
+func (c ServerStatus) CommandId() string {
+	return "server_status"
+}
+
+func (c ServerStatus) Validate() error {
+	return nil
+}
+
+type ChangePass struct {
+	OldPass string `xml:"oldpass,attr"`
+	NewPass string `xml:"newpass,attr"`
+}
+
+func (c ChangePass) CommandId() string {
+	return "change_pass"
+}
+
+func (c ChangePass) Validate() error {
+	if c.OldPass == "" {
+		return invalid(c, "oldpass", "is required")
+	}
+	if c.NewPass == "" {
+		return invalid(c, "newpass", "is required")
+	}
+	if c.OldPass == c.NewPass {
+		return invalid(c, "newpass", "must differ from oldpass")
+	}
+
+	return nil
+}
+
+type GetServTimeDifference struct{}
+
+func (c GetServTimeDifference) CommandId() string {
+	return "get_servtime_difference"
+}
+
+func (c GetServTimeDifference) Validate() error {
+	return nil
+}
+
+type GetConnectorVersion struct{}
+
+func (c GetConnectorVersion) CommandId() string {
+	return "get_connector_version"
+}
+
+func (c GetConnectorVersion) Validate() error {
+	return nil
+}
@@ -0,0 +1,258 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	ConnectorDll       = "dll"
+	ConnectorSimulator = "simulator"
+	ConnectorReplay    = "replay"
+	ReplaySpeedMax     = "max"
+
+	TlsVersion12 = "1.2"
+	TlsVersion13 = "1.3"
+)
+
+// Config is the effective configuration of the server. Every value comes from
+// the defaults, then the yaml file, then environment variables, then flags.
+type Config struct {
+	Server     Server     `yaml:"server"`
+	Tls        Tls        `yaml:"tls"`
+	Connector  Connector  `yaml:"connector"`
+	Session    Session    `yaml:"session"`
+	Supervisor Supervisor `yaml:"supervisor"`
+	Journal    Journal    `yaml:"journal"`
+}
+
+type Server struct {
+	Listen   string `yaml:"listen" env:"TRANSAQ_LISTEN" flag:"listen" usage:"grpc listen address"`
+	PoolSize int    `yaml:"pool_size" env:"TRANSAQ_POOL_SIZE" flag:"pool-size" usage:"market data messages kept in memory"`
+	// orders, trades, positions, server_status and error, the pool grows while
+	// a client lags behind by more than that
+	CriticalPoolSize int `yaml:"critical_pool_size" env:"TRANSAQ_CRITICAL_POOL_SIZE" flag:"critical-pool-size" usage:"initial trading events pool size"`
+}
+
+type Tls struct {
+	Enabled bool `yaml:"enabled" env:"TRANSAQ_TLS_ENABLED" flag:"tls" usage:"serve grpc over tls"`
+	// refuse to start when the certificates can not be loaded, the server
+	// warns and serves without tls otherwise
+	Required bool   `yaml:"required" env:"TRANSAQ_TLS_REQUIRED" flag:"tls-required" usage:"do not fall back to plaintext grpc on tls errors"`
+	CaFile   string `yaml:"ca_file" env:"TRANSAQ_TLS_CA_FILE" flag:"tls-ca-file" usage:"ca certificate for client certificates"`
+	CertFile string `yaml:"cert_file" env:"TRANSAQ_TLS_CERT_FILE" flag:"tls-cert-file" usage:"server certificate"`
+	KeyFile  string `yaml:"key_file" env:"TRANSAQ_TLS_KEY_FILE" flag:"tls-key-file" usage:"server private key"`
+	// require and verify client certificates
+	ClientAuth bool   `yaml:"client_auth" env:"TRANSAQ_TLS_CLIENT_AUTH" flag:"tls-client-auth" usage:"require client certificates"`
+	MinVersion string `yaml:"min_version" env:"TRANSAQ_TLS_MIN_VERSION" flag:"tls-min-version" usage:"minimal tls version, 1.2 or 1.3"`
+}
+
+type Connector struct {
+	// dll on windows/amd64, simulator elsewhere when empty
+	Kind            string `yaml:"kind" env:"TRANSAQ_CONNECTOR" flag:"connector" usage:"dll, simulator or replay"`
+	DllPath         string `yaml:"dll_path" env:"TRANSAQ_DLL_PATH" flag:"dll-path" usage:"txmlconnector dll"`
+	LogPath         string `yaml:"log_path" env:"TRANSAQ_LOG_PATH" flag:"log-path" usage:"connector log directory"`
+	LogLevel        int    `yaml:"log_level" env:"TRANSAQ_LOG_LEVEL" flag:"log-level" usage:"connector log level 1-3"`
+	LogFileLifetime int    `yaml:"log_file_lifetime" env:"TRANSAQ_LOG_LIFETIME" flag:"log-lifetime" usage:"days to keep connector logs, 0 for the connector default"`
+	CaptureDir      string `yaml:"capture_dir" env:"TRANSAQ_CAPTURE_DIR" flag:"capture-dir" usage:"record connector traffic to the directory"`
+	ReplayFile      string `yaml:"replay_file" env:"TRANSAQ_REPLAY_FILE" flag:"replay-file" usage:"capture played by the replay connector"`
+	ReplaySpeed     string `yaml:"replay_speed" env:"TRANSAQ_REPLAY_SPEED" flag:"replay-speed" usage:"replay speed multiplier or max"`
+}
+
+// Session is connected right after start when Login is set.
+type Session struct {
+	Login    string `yaml:"login" env:"TRANSAQ_LOGIN" flag:"login" usage:"broker login for connect on start"`
+	Password string `yaml:"password" env:"TRANSAQ_PASSWORD" secret:"true"`
+	Host     string `yaml:"host" env:"TRANSAQ_HOST" flag:"host" usage:"broker server host"`
+	Port     int    `yaml:"port" env:"TRANSAQ_PORT" flag:"port" usage:"broker server port"`
+}
+
+type Supervisor struct {
+	InitialBackoff time.Duration `yaml:"initial_backoff" env:"TRANSAQ_RECONNECT_INITIAL_BACKOFF"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env:"TRANSAQ_RECONNECT_MAX_BACKOFF"`
+	Multiplier     float64       `yaml:"multiplier" env:"TRANSAQ_RECONNECT_MULTIPLIER"`
+	Jitter         float64       `yaml:"jitter" env:"TRANSAQ_RECONNECT_JITTER"`
+	ConnectTimeout time.Duration `yaml:"connect_timeout" env:"TRANSAQ_RECONNECT_CONNECT_TIMEOUT"`
+}
+
+// Journal keeps every callback message on disk, clients replay it after a restart.
+type Journal struct {
+	Enabled   bool   `yaml:"enabled" env:"TRANSAQ_JOURNAL_ENABLED" flag:"journal" usage:"write callback messages to the on-disk journal"`
+	Directory string `yaml:"directory" env:"TRANSAQ_JOURNAL_DIR" flag:"journal-dir" usage:"journal segments directory"`
+	// megabytes
+	SegmentSize int `yaml:"segment_size" env:"TRANSAQ_JOURNAL_SEGMENT_SIZE" flag:"journal-segment-size" usage:"journal segment size in megabytes"`
+	// megabytes, 0 for no limit
+	MaxSize      int           `yaml:"max_size" env:"TRANSAQ_JOURNAL_MAX_SIZE" flag:"journal-max-size" usage:"journal size limit in megabytes, 0 for no limit"`
+	MaxAge       time.Duration `yaml:"max_age" env:"TRANSAQ_JOURNAL_MAX_AGE" flag:"journal-max-age" usage:"journal age limit, 0 for no limit"`
+	SyncInterval time.Duration `yaml:"sync_interval" env:"TRANSAQ_JOURNAL_SYNC_INTERVAL" usage:"journal fsync interval, 0 to sync every message on the callback thread"`
+}
+
+func Default() Config {
+	initOptions := transaq.DefaultInitOptions()
+	supervisor := transaq.DefaultSupervisorConfig()
+
+	return Config{
+		Server: Server{
+			Listen:           "0.0.0.0:50051",
+			PoolSize:         10000,
+			CriticalPoolSize: 10000,
+		},
+		Tls: Tls{
+			Enabled:    true,
+			CaFile:     "certs/rootCA.crt",
+			CertFile:   "certs/transaqGrpcServiceServer.crt",
+			KeyFile:    "certs/transaqGrpcServiceServer.key",
+			ClientAuth: true,
+			MinVersion: TlsVersion13,
+		},
+		Connector: Connector{
+			DllPath:         initOptions.DllPath,
+			LogPath:         initOptions.LogPath,
+			LogLevel:        int(initOptions.LogLevel),
+			LogFileLifetime: initOptions.LogFileLifetime,
+			ReplaySpeed:     "1",
+		},
+		Supervisor: Supervisor{
+			InitialBackoff: supervisor.InitialBackoff,
+			MaxBackoff:     supervisor.MaxBackoff,
+			Multiplier:     supervisor.Multiplier,
+			Jitter:         supervisor.Jitter,
+			ConnectTimeout: supervisor.ConnectTimeout,
+		},
+		Journal: Journal{
+			Enabled:      true,
+			Directory:    "journal",
+			SegmentSize:  64,
+			MaxSize:      2048,
+			MaxAge:       time.Hour * 24 * 7,
+			SyncInterval: time.Second,
+		},
+	}
+}
+
+func (c *Config) Validate() error {
+	_, _, err := net.SplitHostPort(c.Server.Listen)
+	if err != nil {
+		return fmt.Errorf("server.listen: %w", err)
+	}
+	if c.Server.PoolSize <= 0 || c.Server.CriticalPoolSize <= 0 {
+		return errors.New("server.pool_size and server.critical_pool_size must be positive")
+	}
+
+	if c.Tls.Enabled {
+		if c.Tls.CertFile == "" || c.Tls.KeyFile == "" {
+			return errors.New("tls.cert_file and tls.key_file are required when tls is enabled")
+		}
+		if c.Tls.ClientAuth && c.Tls.CaFile == "" {
+			return errors.New("tls.ca_file is required for client_auth")
+		}
+		if c.Tls.MinVersion != TlsVersion12 && c.Tls.MinVersion != TlsVersion13 {
+			return fmt.Errorf("tls.min_version must be %s or %s", TlsVersion12, TlsVersion13)
+		}
+	}
+
+	switch c.Connector.Kind {
+	case "", ConnectorDll, ConnectorSimulator:
+	case ConnectorReplay:
+		if c.Connector.ReplayFile == "" {
+			return errors.New("connector.replay_file is required for the replay connector")
+		}
+		_, err = c.Connector.Speed()
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("connector.kind %q is unknown", c.Connector.Kind)
+	}
+
+	err = c.Connector.InitOptions().Validate()
+	if err != nil {
+		return fmt.Errorf("connector: %w", err)
+	}
+
+	if c.Session.Login != "" {
+		if c.Session.Host == "" || c.Session.Password == "" {
+			return errors.New("session.host and session.password are required with session.login")
+		}
+		if c.Session.Port < 1 || c.Session.Port > 65535 {
+			return errors.New("session.port must be in range 1-65535")
+		}
+	}
+
+	if c.Supervisor.InitialBackoff <= 0 || c.Supervisor.MaxBackoff < c.Supervisor.InitialBackoff {
+		return errors.New("supervisor backoff must be positive and max_backoff not less than initial_backoff")
+	}
+	if c.Supervisor.Multiplier < 1 {
+		return errors.New("supervisor.multiplier must not be less than 1")
+	}
+	if c.Supervisor.Jitter < 0 || c.Supervisor.Jitter >= 1 {
+		return errors.New("supervisor.jitter must be in range [0, 1)")
+	}
+	if c.Supervisor.ConnectTimeout <= 0 {
+		return errors.New("supervisor.connect_timeout must be positive")
+	}
+
+	if c.Journal.Enabled {
+		if c.Journal.Directory == "" {
+			return errors.New("journal.directory is required when the journal is enabled")
+		}
+		if c.Journal.SegmentSize <= 0 {
+			return errors.New("journal.segment_size must be positive")
+		}
+		if c.Journal.MaxSize < 0 || c.Journal.MaxAge < 0 || c.Journal.SyncInterval < 0 {
+			return errors.New("journal.max_size, journal.max_age and journal.sync_interval must not be negative")
+		}
+	}
+
+	return nil
+}
+
+func (c Connector) InitOptions() transaq.InitOptions {
+	return transaq.InitOptions{
+		DllPath:         c.DllPath,
+		LogPath:         c.LogPath,
+		LogLevel:        transaq.LogLevel(c.LogLevel),
+		LogFileLifetime: c.LogFileLifetime,
+	}
+}
+
+// Speed of the replay, transaq.ReplaySpeedMax for max.
+func (c Connector) Speed() (float64, error) {
+	if c.ReplaySpeed == ReplaySpeedMax {
+		return transaq.ReplaySpeedMax, nil
+	}
+
+	speed, err := strconv.ParseFloat(c.ReplaySpeed, 64)
+	if err != nil || speed <= 0 {
+		return 0, fmt.Errorf("connector.replay_speed %q must be a positive number or %s", c.ReplaySpeed, ReplaySpeedMax)
+	}
+
+	return speed, nil
+}
+
+func (s Supervisor) SupervisorConfig() transaq.SupervisorConfig {
+	return transaq.SupervisorConfig{
+		InitialBackoff: s.InitialBackoff,
+		MaxBackoff:     s.MaxBackoff,
+		Multiplier:     s.Multiplier,
+		Jitter:         s.Jitter,
+		ConnectTimeout: s.ConnectTimeout,
+	}
+}
+
+func (j Journal) Options() journal.Options {
+	const megabyte = 1 << 20
+
+	return journal.Options{
+		Directory:    j.Directory,
+		SegmentSize:  int64(j.SegmentSize) * megabyte,
+		MaxSize:      int64(j.MaxSize) * megabyte,
+		MaxAge:       j.MaxAge,
+		SyncInterval: j.SyncInterval,
+	}
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		// a part of the error, empty for a valid configuration
+		want string
+	}{
+		{name: "defaults", modify: func(c *Config) {}},
+		{name: "bad listen", modify: func(c *Config) { c.Server.Listen = "50051" }, want: "server.listen"},
+		{name: "zero pool", modify: func(c *Config) { c.Server.CriticalPoolSize = 0 }, want: "pool_size"},
+		{name: "tls without cert", modify: func(c *Config) { c.Tls.CertFile = "" }, want: "tls.cert_file"},
+		{name: "client auth without ca", modify: func(c *Config) { c.Tls.CaFile = "" }, want: "tls.ca_file"},
+		{name: "tls version", modify: func(c *Config) { c.Tls.MinVersion = "1.1" }, want: "tls.min_version"},
+		{name: "tls disabled skips its checks", modify: func(c *Config) {
+			c.Tls.Enabled = false
+			c.Tls.CertFile = ""
+			c.Tls.MinVersion = ""
+		}},
+		{name: "unknown connector", modify: func(c *Config) { c.Connector.Kind = "com" }, want: "connector.kind"},
+		{name: "replay without file", modify: func(c *Config) { c.Connector.Kind = ConnectorReplay }, want: "replay_file"},
+		{name: "replay speed", modify: func(c *Config) {
+			c.Connector.Kind = ConnectorReplay
+			c.Connector.ReplayFile = "capture.jsonl"
+			c.Connector.ReplaySpeed = "-1"
+		}, want: "replay_speed"},
+		{name: "replay at max speed", modify: func(c *Config) {
+			c.Connector.Kind = ConnectorReplay
+			c.Connector.ReplayFile = "capture.jsonl"
+			c.Connector.ReplaySpeed = ReplaySpeedMax
+		}},
+		{name: "connector log level", modify: func(c *Config) { c.Connector.LogLevel = 4 }, want: "connector"},
+		{name: "session without password", modify: func(c *Config) {
+			c.Session.Login = "login"
+			c.Session.Host = "host"
+			c.Session.Port = 3900
+		}, want: "session.password"},
+		{name: "session port", modify: func(c *Config) {
+			c.Session = Session{Login: "login", Password: "password", Host: "host", Port: 70000}
+		}, want: "session.port"},
+		{name: "backoff order", modify: func(c *Config) { c.Supervisor.MaxBackoff = time.Millisecond }, want: "max_backoff"},
+		{name: "multiplier", modify: func(c *Config) { c.Supervisor.Multiplier = 0.5 }, want: "multiplier"},
+		{name: "jitter", modify: func(c *Config) { c.Supervisor.Jitter = 1 }, want: "jitter"},
+		{name: "connect timeout", modify: func(c *Config) { c.Supervisor.ConnectTimeout = 0 }, want: "connect_timeout"},
+		{name: "journal directory", modify: func(c *Config) { c.Journal.Directory = "" }, want: "journal.directory"},
+		{name: "journal segment", modify: func(c *Config) { c.Journal.SegmentSize = 0 }, want: "segment_size"},
+		{name: "journal negative", modify: func(c *Config) { c.Journal.SyncInterval = -time.Second }, want: "sync_interval"},
+		{name: "journal disabled skips its checks", modify: func(c *Config) {
+			c.Journal.Enabled = false
+			c.Journal.Directory = ""
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := Default()
+			test.modify(&config)
+
+			err := config.Validate()
+			if test.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("got %v, want an error with %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		want     string
+	}{
+		{name: "set", password: "secret", want: "password: '" + redacted + "'"},
+		{name: "empty", password: "", want: `password: ""`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := Default()
+			config.Session = Session{Login: "login", Password: test.password, Host: "host", Port: 3900}
+
+			rendered := config.Redacted()
+			if strings.Contains(rendered, "secret") || !strings.Contains(rendered, test.want) {
+				t.Fatalf("got\n%s\nwant %s", rendered, test.want)
+			}
+			if !strings.Contains(rendered, "login: login") {
+				t.Fatalf("got\n%s\nwant the login", rendered)
+			}
+			// the receiver is a copy
+			if config.Session.Password != test.password {
+				t.Fatalf("password changed to %s", config.Session.Password)
+			}
+		})
+	}
+}
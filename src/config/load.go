@@ -0,0 +1,195 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FileEnv  = "TRANSAQ_CONFIG"
+	fileFlag = "config"
+	redacted = "******"
+)
+
+// Load builds the configuration from defaults, the yaml file given by -config
+// or TRANSAQ_CONFIG, environment variables and flags, later sources win.
+func Load(args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	config := Default()
+
+	flags := flag.NewFlagSet("transaq-grpc", flag.ContinueOnError)
+	path := flags.String(fileFlag, "", "yaml configuration file, "+FileEnv+" by default")
+	flagValues := map[string]string{}
+	err := walk(&config, func(field reflect.StructField, _ reflect.Value, name string) error {
+		flagName := field.Tag.Get("flag")
+		if flagName == "" {
+			return nil
+		}
+
+		flags.Func(flagName, field.Tag.Get("usage"), func(value string) error {
+			flagValues[name] = value
+			return nil
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = flags.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if *path == "" {
+		*path, _ = lookupEnv(FileEnv)
+	}
+	if *path != "" {
+		err = loadFile(&config, *path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = walk(&config, func(field reflect.StructField, value reflect.Value, name string) error {
+		env := field.Tag.Get("env")
+		if env != "" {
+			if raw, ok := lookupEnv(env); ok {
+				err := setValue(value, raw)
+				if err != nil {
+					return fmt.Errorf("%s: %w", env, err)
+				}
+			}
+		}
+
+		if raw, ok := flagValues[name]; ok {
+			err := setValue(value, raw)
+			if err != nil {
+				return fmt.Errorf("-%s: %w", field.Tag.Get("flag"), err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func loadFile(config *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(config)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Redacted renders the configuration as yaml with secrets replaced.
+func (c Config) Redacted() string {
+	err := walk(&c, func(field reflect.StructField, value reflect.Value, _ string) error {
+		if field.Tag.Get("secret") == "true" && !value.IsZero() {
+			value.SetString(redacted)
+		}
+		return nil
+	})
+	if err != nil {
+		return err.Error()
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(data)
+}
+
+// walk visits leaf fields of the nested sections, name is the dotted yaml path.
+func walk(config *Config, visit func(field reflect.StructField, value reflect.Value, name string) error) error {
+	return walkStruct(reflect.ValueOf(config).Elem(), "", visit)
+}
+
+func walkStruct(value reflect.Value, prefix string, visit func(reflect.StructField, reflect.Value, string) error) error {
+	valueType := value.Type()
+
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		name := prefix + strings.Split(field.Tag.Get("yaml"), ",")[0]
+
+		var err error
+		if field.Type.Kind() == reflect.Struct {
+			err = walkStruct(value.Field(i), name+".", visit)
+		} else {
+			err = visit(field, value.Field(i), name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func setValue(value reflect.Value, raw string) error {
+	if value.Type() == durationType {
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(duration))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		value.SetBool(parsed)
+	case reflect.Int:
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(parsed))
+	case reflect.Float64:
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		value.SetFloat(parsed)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(data), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func lookupIn(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	file := writeConfig(t, `
+server:
+  listen: 127.0.0.1:1000
+  pool_size: 100
+tls:
+  enabled: false
+journal:
+  max_age: 1h
+`)
+
+	tests := []struct {
+		name   string
+		args   []string
+		env    map[string]string
+		listen string
+		pool   int
+		maxAge time.Duration
+	}{
+		{
+			name:   "defaults",
+			listen: "0.0.0.0:50051",
+			pool:   10000,
+			maxAge: time.Hour * 24 * 7,
+		},
+		{
+			name:   "file over defaults",
+			args:   []string{"-config", file},
+			listen: "127.0.0.1:1000",
+			pool:   100,
+			maxAge: time.Hour,
+		},
+		{
+			name:   "file from the environment",
+			env:    map[string]string{FileEnv: file},
+			listen: "127.0.0.1:1000",
+			pool:   100,
+			maxAge: time.Hour,
+		},
+		{
+			name:   "environment over file",
+			args:   []string{"-config", file},
+			env:    map[string]string{"TRANSAQ_LISTEN": "127.0.0.1:2000", "TRANSAQ_JOURNAL_MAX_AGE": "2h"},
+			listen: "127.0.0.1:2000",
+			pool:   100,
+			maxAge: time.Hour * 2,
+		},
+		{
+			name:   "flags over environment",
+			args:   []string{"-config", file, "-listen", "127.0.0.1:3000", "-journal-max-age", "3h"},
+			env:    map[string]string{"TRANSAQ_LISTEN": "127.0.0.1:2000", "TRANSAQ_POOL_SIZE": "200"},
+			listen: "127.0.0.1:3000",
+			pool:   200,
+			maxAge: time.Hour * 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := Load(test.args, lookupIn(test.env))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if config.Server.Listen != test.listen || config.Server.PoolSize != test.pool || config.Journal.MaxAge != test.maxAge {
+				t.Fatalf("got listen %s, pool %d, max age %s, want %s, %d, %s",
+					config.Server.Listen, config.Server.PoolSize, config.Journal.MaxAge, test.listen, test.pool, test.maxAge)
+			}
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+		file string
+		// a part of the error
+		want string
+	}{
+		{name: "unknown flag", args: []string{"-unknown"}, want: "not defined"},
+		{name: "bad int flag", args: []string{"-pool-size", "many"}, want: "-pool-size"},
+		{name: "bad env duration", env: map[string]string{"TRANSAQ_JOURNAL_MAX_AGE": "week"}, want: "TRANSAQ_JOURNAL_MAX_AGE"},
+		{name: "bad env bool", env: map[string]string{"TRANSAQ_TLS_ENABLED": "maybe"}, want: "TRANSAQ_TLS_ENABLED"},
+		{name: "missing file", args: []string{"-config", "missing.yaml"}, want: "missing.yaml"},
+		{name: "unknown file field", file: "server:\n  port: 1\n", want: "field port not found"},
+		{name: "invalid result", env: map[string]string{"TRANSAQ_POOL_SIZE": "0"}, want: "pool_size"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			args := test.args
+			if test.file != "" {
+				args = append(args, "-config", writeConfig(t, test.file))
+			}
+
+			_, err := Load(args, lookupIn(test.env))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("got %v, want an error with %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestLoadEmptyFile(t *testing.T) {
+	config, err := Load([]string{"-config", writeConfig(t, "")}, lookupIn(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Server.Listen != Default().Server.Listen {
+		t.Fatalf("listen %s, want the default", config.Server.Listen)
+	}
+}
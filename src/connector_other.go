@@ -0,0 +1,17 @@
+//go:build !(windows && amd64)
+
+package main
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/config"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"github.com/rs/zerolog"
+)
+
+func newConnector(connectorConfig config.Connector, _ int, logger *zerolog.Logger) transaq.Connector {
+	if connectorConfig.Kind != "" && connectorConfig.Kind != config.ConnectorSimulator {
+		logger.Warn().Msgf("Connector %s is available only on windows/amd64, using simulator", connectorConfig.Kind)
+	}
+
+	return transaq.NewSimulatorConnector(logger)
+}
@@ -0,0 +1,18 @@
+//go:build windows && amd64
+
+package main
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/config"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"github.com/rs/zerolog"
+)
+
+func newConnector(connectorConfig config.Connector, poolSize int, logger *zerolog.Logger) transaq.Connector {
+	if connectorConfig.Kind == config.ConnectorSimulator {
+		logger.Warn().Msg("Using transaq simulator instead of dll")
+		return transaq.NewSimulatorConnector(logger)
+	}
+
+	return transaq.NewDllConnector(logger, poolSize, connectorConfig.InitOptions())
+}
@@ -9,6 +9,8 @@ package server
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,269 +22,6921 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type DataRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+// what happens when the client falls behind by more than max_lag messages
+type SlowConsumerPolicy int32
 
-func (x *DataRequest) Reset() {
-	*x = DataRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+const (
+	// skip the oldest pending messages
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_OLDEST SlowConsumerPolicy = 0
+	// keep the pending messages and skip the new ones until there is room
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_NEWEST SlowConsumerPolicy = 1
+	// keep up to the server queue of pending messages for block_timeout, then
+	// drop the oldest. The server never waits for the client
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_BLOCK SlowConsumerPolicy = 2
+	// end the stream with RESOURCE_EXHAUSTED
+	SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DISCONNECT SlowConsumerPolicy = 3
+)
+
+// Enum value maps for SlowConsumerPolicy.
+var (
+	SlowConsumerPolicy_name = map[int32]string{
+		0: "SLOW_CONSUMER_POLICY_DROP_OLDEST",
+		1: "SLOW_CONSUMER_POLICY_DROP_NEWEST",
+		2: "SLOW_CONSUMER_POLICY_BLOCK",
+		3: "SLOW_CONSUMER_POLICY_DISCONNECT",
+	}
+	SlowConsumerPolicy_value = map[string]int32{
+		"SLOW_CONSUMER_POLICY_DROP_OLDEST": 0,
+		"SLOW_CONSUMER_POLICY_DROP_NEWEST": 1,
+		"SLOW_CONSUMER_POLICY_BLOCK":       2,
+		"SLOW_CONSUMER_POLICY_DISCONNECT":  3,
 	}
+)
+
+func (x SlowConsumerPolicy) Enum() *SlowConsumerPolicy {
+	p := new(SlowConsumerPolicy)
+	*p = x
+	return p
 }
 
-func (x *DataRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x SlowConsumerPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*DataRequest) ProtoMessage() {}
+func (SlowConsumerPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[0].Descriptor()
+}
 
-func (x *DataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_connect_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (SlowConsumerPolicy) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[0]
 }
 
-// Deprecated: Use DataRequest.ProtoReflect.Descriptor instead.
-func (*DataRequest) Descriptor() ([]byte, []int) {
+func (x SlowConsumerPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlowConsumerPolicy.Descriptor instead.
+func (SlowConsumerPolicy) EnumDescriptor() ([]byte, []int) {
 	return file_connect_proto_rawDescGZIP(), []int{0}
 }
 
-type DataResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ConnectionState int32
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-}
+const (
+	ConnectionState_CONNECTION_STATE_UNKNOWN      ConnectionState = 0
+	ConnectionState_CONNECTION_STATE_CONNECTED    ConnectionState = 1
+	ConnectionState_CONNECTION_STATE_RECOVERING   ConnectionState = 2
+	ConnectionState_CONNECTION_STATE_DISCONNECTED ConnectionState = 3
+	ConnectionState_CONNECTION_STATE_ERROR        ConnectionState = 4
+	// dll is not loaded or already released
+	ConnectionState_CONNECTION_STATE_UNINITIALIZED ConnectionState = 5
+	// connect is accepted, waiting for server_status
+	ConnectionState_CONNECTION_STATE_CONNECTING ConnectionState = 6
+	// disconnect is accepted, waiting for server_status
+	ConnectionState_CONNECTION_STATE_DISCONNECTING ConnectionState = 7
+)
 
-func (x *DataResponse) Reset() {
-	*x = DataResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ConnectionState.
+var (
+	ConnectionState_name = map[int32]string{
+		0: "CONNECTION_STATE_UNKNOWN",
+		1: "CONNECTION_STATE_CONNECTED",
+		2: "CONNECTION_STATE_RECOVERING",
+		3: "CONNECTION_STATE_DISCONNECTED",
+		4: "CONNECTION_STATE_ERROR",
+		5: "CONNECTION_STATE_UNINITIALIZED",
+		6: "CONNECTION_STATE_CONNECTING",
+		7: "CONNECTION_STATE_DISCONNECTING",
+	}
+	ConnectionState_value = map[string]int32{
+		"CONNECTION_STATE_UNKNOWN":       0,
+		"CONNECTION_STATE_CONNECTED":     1,
+		"CONNECTION_STATE_RECOVERING":    2,
+		"CONNECTION_STATE_DISCONNECTED":  3,
+		"CONNECTION_STATE_ERROR":         4,
+		"CONNECTION_STATE_UNINITIALIZED": 5,
+		"CONNECTION_STATE_CONNECTING":    6,
+		"CONNECTION_STATE_DISCONNECTING": 7,
 	}
+)
+
+func (x ConnectionState) Enum() *ConnectionState {
+	p := new(ConnectionState)
+	*p = x
+	return p
 }
 
-func (x *DataResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*DataResponse) ProtoMessage() {}
+func (ConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[1].Descriptor()
+}
 
-func (x *DataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_connect_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ConnectionState) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[1]
 }
 
-// Deprecated: Use DataResponse.ProtoReflect.Descriptor instead.
-func (*DataResponse) Descriptor() ([]byte, []int) {
+func (x ConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectionState.Descriptor instead.
+func (ConnectionState) EnumDescriptor() ([]byte, []int) {
 	return file_connect_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *DataResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+type MarketDataKind int32
+
+const (
+	MarketDataKind_MARKET_DATA_KIND_UNSPECIFIED MarketDataKind = 0
+	MarketDataKind_MARKET_DATA_KIND_ALL_TRADES  MarketDataKind = 1
+	MarketDataKind_MARKET_DATA_KIND_QUOTATIONS  MarketDataKind = 2
+	MarketDataKind_MARKET_DATA_KIND_QUOTES      MarketDataKind = 3
+)
+
+// Enum value maps for MarketDataKind.
+var (
+	MarketDataKind_name = map[int32]string{
+		0: "MARKET_DATA_KIND_UNSPECIFIED",
+		1: "MARKET_DATA_KIND_ALL_TRADES",
+		2: "MARKET_DATA_KIND_QUOTATIONS",
+		3: "MARKET_DATA_KIND_QUOTES",
 	}
-	return ""
+	MarketDataKind_value = map[string]int32{
+		"MARKET_DATA_KIND_UNSPECIFIED": 0,
+		"MARKET_DATA_KIND_ALL_TRADES":  1,
+		"MARKET_DATA_KIND_QUOTATIONS":  2,
+		"MARKET_DATA_KIND_QUOTES":      3,
+	}
+)
+
+func (x MarketDataKind) Enum() *MarketDataKind {
+	p := new(MarketDataKind)
+	*p = x
+	return p
 }
 
-type SendCommandRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x MarketDataKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (MarketDataKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[2].Descriptor()
 }
 
-func (x *SendCommandRequest) Reset() {
-	*x = SendCommandRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (MarketDataKind) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[2]
 }
 
-func (x *SendCommandRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x MarketDataKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*SendCommandRequest) ProtoMessage() {}
+// Deprecated: Use MarketDataKind.Descriptor instead.
+func (MarketDataKind) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{2}
+}
 
-func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_connect_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type BuySell int32
+
+const (
+	BuySell_BUY_SELL_UNSPECIFIED BuySell = 0
+	BuySell_BUY_SELL_BUY         BuySell = 1
+	BuySell_BUY_SELL_SELL        BuySell = 2
+)
+
+// Enum value maps for BuySell.
+var (
+	BuySell_name = map[int32]string{
+		0: "BUY_SELL_UNSPECIFIED",
+		1: "BUY_SELL_BUY",
+		2: "BUY_SELL_SELL",
 	}
-	return mi.MessageOf(x)
+	BuySell_value = map[string]int32{
+		"BUY_SELL_UNSPECIFIED": 0,
+		"BUY_SELL_BUY":         1,
+		"BUY_SELL_SELL":        2,
+	}
+)
+
+func (x BuySell) Enum() *BuySell {
+	p := new(BuySell)
+	*p = x
+	return p
 }
 
-// Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
-func (*SendCommandRequest) Descriptor() ([]byte, []int) {
-	return file_connect_proto_rawDescGZIP(), []int{2}
+func (x BuySell) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SendCommandRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+func (BuySell) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[3].Descriptor()
 }
 
-type SendCommandResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (BuySell) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[3]
+}
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Code    uint64 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+func (x BuySell) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *SendCommandResponse) Reset() {
-	*x = SendCommandResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Use BuySell.Descriptor instead.
+func (BuySell) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{3}
+}
+
+type OrderUnfilled int32
+
+const (
+	OrderUnfilled_ORDER_UNFILLED_PUT_IN_QUEUE OrderUnfilled = 0
+	OrderUnfilled_ORDER_UNFILLED_FOK          OrderUnfilled = 1
+	OrderUnfilled_ORDER_UNFILLED_IOC          OrderUnfilled = 2
+)
+
+// Enum value maps for OrderUnfilled.
+var (
+	OrderUnfilled_name = map[int32]string{
+		0: "ORDER_UNFILLED_PUT_IN_QUEUE",
+		1: "ORDER_UNFILLED_FOK",
+		2: "ORDER_UNFILLED_IOC",
+	}
+	OrderUnfilled_value = map[string]int32{
+		"ORDER_UNFILLED_PUT_IN_QUEUE": 0,
+		"ORDER_UNFILLED_FOK":          1,
+		"ORDER_UNFILLED_IOC":          2,
 	}
+)
+
+func (x OrderUnfilled) Enum() *OrderUnfilled {
+	p := new(OrderUnfilled)
+	*p = x
+	return p
 }
 
-func (x *SendCommandResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x OrderUnfilled) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*SendCommandResponse) ProtoMessage() {}
+func (OrderUnfilled) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[4].Descriptor()
+}
 
-func (x *SendCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_connect_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (OrderUnfilled) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[4]
 }
 
-// Deprecated: Use SendCommandResponse.ProtoReflect.Descriptor instead.
-func (*SendCommandResponse) Descriptor() ([]byte, []int) {
-	return file_connect_proto_rawDescGZIP(), []int{3}
+func (x OrderUnfilled) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *SendCommandResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+// Deprecated: Use OrderUnfilled.Descriptor instead.
+func (OrderUnfilled) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{4}
+}
+
+type ValidityKind int32
+
+const (
+	// the connector default
+	ValidityKind_VALIDITY_KIND_UNSPECIFIED       ValidityKind = 0
+	ValidityKind_VALIDITY_KIND_NOW               ValidityKind = 1
+	ValidityKind_VALIDITY_KIND_TILL_CANCELED     ValidityKind = 2
+	ValidityKind_VALIDITY_KIND_TILL_MARKET_CLOSE ValidityKind = 3
+	ValidityKind_VALIDITY_KIND_TILL_MARKET_OPEN  ValidityKind = 4
+	// at the given time
+	ValidityKind_VALIDITY_KIND_AT ValidityKind = 5
+)
+
+// Enum value maps for ValidityKind.
+var (
+	ValidityKind_name = map[int32]string{
+		0: "VALIDITY_KIND_UNSPECIFIED",
+		1: "VALIDITY_KIND_NOW",
+		2: "VALIDITY_KIND_TILL_CANCELED",
+		3: "VALIDITY_KIND_TILL_MARKET_CLOSE",
+		4: "VALIDITY_KIND_TILL_MARKET_OPEN",
+		5: "VALIDITY_KIND_AT",
 	}
-	return ""
+	ValidityKind_value = map[string]int32{
+		"VALIDITY_KIND_UNSPECIFIED":       0,
+		"VALIDITY_KIND_NOW":               1,
+		"VALIDITY_KIND_TILL_CANCELED":     2,
+		"VALIDITY_KIND_TILL_MARKET_CLOSE": 3,
+		"VALIDITY_KIND_TILL_MARKET_OPEN":  4,
+		"VALIDITY_KIND_AT":                5,
+	}
+)
+
+func (x ValidityKind) Enum() *ValidityKind {
+	p := new(ValidityKind)
+	*p = x
+	return p
 }
 
-func (x *SendCommandResponse) GetCode() uint64 {
-	if x != nil {
-		return x.Code
+func (x ValidityKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidityKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[5].Descriptor()
+}
+
+func (ValidityKind) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[5]
+}
+
+func (x ValidityKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidityKind.Descriptor instead.
+func (ValidityKind) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{5}
+}
+
+type CondType int32
+
+const (
+	CondType_COND_TYPE_UNSPECIFIED CondType = 0
+	CondType_COND_TYPE_BID         CondType = 1
+	CondType_COND_TYPE_BID_OR_LAST CondType = 2
+	CondType_COND_TYPE_ASK         CondType = 3
+	CondType_COND_TYPE_ASK_OR_LAST CondType = 4
+	CondType_COND_TYPE_TIME        CondType = 5
+	CondType_COND_TYPE_COV_DOWN    CondType = 6
+	CondType_COND_TYPE_COV_UP      CondType = 7
+	CondType_COND_TYPE_LAST_UP     CondType = 8
+	CondType_COND_TYPE_LAST_DOWN   CondType = 9
+)
+
+// Enum value maps for CondType.
+var (
+	CondType_name = map[int32]string{
+		0: "COND_TYPE_UNSPECIFIED",
+		1: "COND_TYPE_BID",
+		2: "COND_TYPE_BID_OR_LAST",
+		3: "COND_TYPE_ASK",
+		4: "COND_TYPE_ASK_OR_LAST",
+		5: "COND_TYPE_TIME",
+		6: "COND_TYPE_COV_DOWN",
+		7: "COND_TYPE_COV_UP",
+		8: "COND_TYPE_LAST_UP",
+		9: "COND_TYPE_LAST_DOWN",
 	}
-	return 0
+	CondType_value = map[string]int32{
+		"COND_TYPE_UNSPECIFIED": 0,
+		"COND_TYPE_BID":         1,
+		"COND_TYPE_BID_OR_LAST": 2,
+		"COND_TYPE_ASK":         3,
+		"COND_TYPE_ASK_OR_LAST": 4,
+		"COND_TYPE_TIME":        5,
+		"COND_TYPE_COV_DOWN":    6,
+		"COND_TYPE_COV_UP":      7,
+		"COND_TYPE_LAST_UP":     8,
+		"COND_TYPE_LAST_DOWN":   9,
+	}
+)
+
+func (x CondType) Enum() *CondType {
+	p := new(CondType)
+	*p = x
+	return p
 }
 
-var File_connect_proto protoreflect.FileDescriptor
+func (x CondType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-var file_connect_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x0d, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28,
-	0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x82, 0x01,
-	0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x34, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (CondType) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[6].Descriptor()
+}
+
+func (CondType) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[6]
 }
 
+func (x CondType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CondType.Descriptor instead.
+func (CondType) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{6}
+}
+
+type MoveFlag int32
+
+const (
+	MoveFlag_MOVE_FLAG_KEEP_QUANTITY MoveFlag = 0
+	MoveFlag_MOVE_FLAG_SET_QUANTITY  MoveFlag = 1
+	// only if the order is not partially filled
+	MoveFlag_MOVE_FLAG_SET_QUANTITY_IF_UNFILLED MoveFlag = 2
+)
+
+// Enum value maps for MoveFlag.
 var (
-	file_connect_proto_rawDescOnce sync.Once
-	file_connect_proto_rawDescData = file_connect_proto_rawDesc
+	MoveFlag_name = map[int32]string{
+		0: "MOVE_FLAG_KEEP_QUANTITY",
+		1: "MOVE_FLAG_SET_QUANTITY",
+		2: "MOVE_FLAG_SET_QUANTITY_IF_UNFILLED",
+	}
+	MoveFlag_value = map[string]int32{
+		"MOVE_FLAG_KEEP_QUANTITY":            0,
+		"MOVE_FLAG_SET_QUANTITY":             1,
+		"MOVE_FLAG_SET_QUANTITY_IF_UNFILLED": 2,
+	}
 )
 
-func file_connect_proto_rawDescGZIP() []byte {
-	file_connect_proto_rawDescOnce.Do(func() {
-		file_connect_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_proto_rawDescData)
-	})
-	return file_connect_proto_rawDescData
+func (x MoveFlag) Enum() *MoveFlag {
+	p := new(MoveFlag)
+	*p = x
+	return p
 }
 
-var file_connect_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_connect_proto_goTypes = []interface{}{
-	(*DataRequest)(nil),         // 0: DataRequest
-	(*DataResponse)(nil),        // 1: DataResponse
-	(*SendCommandRequest)(nil),  // 2: SendCommandRequest
-	(*SendCommandResponse)(nil), // 3: SendCommandResponse
+func (x MoveFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
-var file_connect_proto_depIdxs = []int32{
-	0, // 0: ConnectService.FetchResponseData:input_type -> DataRequest
-	2, // 1: ConnectService.SendCommand:input_type -> SendCommandRequest
-	1, // 2: ConnectService.FetchResponseData:output_type -> DataResponse
-	3, // 3: ConnectService.SendCommand:output_type -> SendCommandResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (MoveFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[7].Descriptor()
 }
 
-func init() { file_connect_proto_init() }
-func file_connect_proto_init() {
-	if File_connect_proto != nil {
-		return
+func (MoveFlag) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[7]
+}
+
+func (x MoveFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MoveFlag.Descriptor instead.
+func (MoveFlag) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{7}
+}
+
+type OrderRejectionReason int32
+
+const (
+	OrderRejectionReason_ORDER_REJECTION_REASON_UNSPECIFIED OrderRejectionReason = 0
+	// the request failed validation on the server, field names it
+	OrderRejectionReason_ORDER_REJECTION_REASON_INVALID OrderRejectionReason = 1
+	// the session is not connected
+	OrderRejectionReason_ORDER_REJECTION_REASON_NOT_CONNECTED OrderRejectionReason = 2
+	// Transaq or the broker refused the command
+	OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED OrderRejectionReason = 3
+	// the connector could not read the command
+	OrderRejectionReason_ORDER_REJECTION_REASON_MALFORMED OrderRejectionReason = 4
+)
+
+// Enum value maps for OrderRejectionReason.
+var (
+	OrderRejectionReason_name = map[int32]string{
+		0: "ORDER_REJECTION_REASON_UNSPECIFIED",
+		1: "ORDER_REJECTION_REASON_INVALID",
+		2: "ORDER_REJECTION_REASON_NOT_CONNECTED",
+		3: "ORDER_REJECTION_REASON_REJECTED",
+		4: "ORDER_REJECTION_REASON_MALFORMED",
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_connect_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
+	OrderRejectionReason_value = map[string]int32{
+		"ORDER_REJECTION_REASON_UNSPECIFIED":   0,
+		"ORDER_REJECTION_REASON_INVALID":       1,
+		"ORDER_REJECTION_REASON_NOT_CONNECTED": 2,
+		"ORDER_REJECTION_REASON_REJECTED":      3,
+		"ORDER_REJECTION_REASON_MALFORMED":     4,
+	}
+)
+
+func (x OrderRejectionReason) Enum() *OrderRejectionReason {
+	p := new(OrderRejectionReason)
+	*p = x
+	return p
+}
+
+func (x OrderRejectionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderRejectionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[8].Descriptor()
+}
+
+func (OrderRejectionReason) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[8]
+}
+
+func (x OrderRejectionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderRejectionReason.Descriptor instead.
+func (OrderRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{8}
+}
+
+type OrderPhase int32
+
+const (
+	// sent to the exchange or waiting for a condition
+	OrderPhase_ORDER_PHASE_PENDING   OrderPhase = 0
+	OrderPhase_ORDER_PHASE_ACTIVE    OrderPhase = 1
+	OrderPhase_ORDER_PHASE_FILLED    OrderPhase = 2
+	OrderPhase_ORDER_PHASE_CANCELLED OrderPhase = 3
+	OrderPhase_ORDER_PHASE_REJECTED  OrderPhase = 4
+	OrderPhase_ORDER_PHASE_EXPIRED   OrderPhase = 5
+	// a stop order has placed its order
+	OrderPhase_ORDER_PHASE_TRIGGERED OrderPhase = 6
+	// a status the server does not know, see the status itself
+	OrderPhase_ORDER_PHASE_UNKNOWN OrderPhase = 7
+)
+
+// Enum value maps for OrderPhase.
+var (
+	OrderPhase_name = map[int32]string{
+		0: "ORDER_PHASE_PENDING",
+		1: "ORDER_PHASE_ACTIVE",
+		2: "ORDER_PHASE_FILLED",
+		3: "ORDER_PHASE_CANCELLED",
+		4: "ORDER_PHASE_REJECTED",
+		5: "ORDER_PHASE_EXPIRED",
+		6: "ORDER_PHASE_TRIGGERED",
+		7: "ORDER_PHASE_UNKNOWN",
+	}
+	OrderPhase_value = map[string]int32{
+		"ORDER_PHASE_PENDING":   0,
+		"ORDER_PHASE_ACTIVE":    1,
+		"ORDER_PHASE_FILLED":    2,
+		"ORDER_PHASE_CANCELLED": 3,
+		"ORDER_PHASE_REJECTED":  4,
+		"ORDER_PHASE_EXPIRED":   5,
+		"ORDER_PHASE_TRIGGERED": 6,
+		"ORDER_PHASE_UNKNOWN":   7,
+	}
+)
+
+func (x OrderPhase) Enum() *OrderPhase {
+	p := new(OrderPhase)
+	*p = x
+	return p
+}
+
+func (x OrderPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_proto_enumTypes[9].Descriptor()
+}
+
+func (OrderPhase) Type() protoreflect.EnumType {
+	return &file_connect_proto_enumTypes[9]
+}
+
+func (x OrderPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderPhase.Descriptor instead.
+func (OrderPhase) EnumDescriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{9}
+}
+
+type SecurityFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seccode string `protobuf:"bytes,1,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	// empty matches every board
+	Board string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+}
+
+func (x *SecurityFilter) Reset() {
+	*x = SecurityFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityFilter) ProtoMessage() {}
+
+func (x *SecurityFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityFilter.ProtoReflect.Descriptor instead.
+func (*SecurityFilter) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecurityFilter) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *SecurityFilter) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+// MessageFilter is evaluated on the server, a message is sent with the elements
+// that match every set criterion. An element without the criterion field passes
+// it: securities keeps money positions and server_status, clients keeps quotes
+// and securities, markets keeps clients without a market. Limit types as well
+// to drop them.
+type MessageFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// root elements: orders, trades, positions... empty passes every type
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// matched against seccode and board, an element without seccode passes
+	Securities []*SecurityFilter `protobuf:"bytes,2,rep,name=securities,proto3" json:"securities,omitempty"`
+	// an element without client passes
+	Clients []string `protobuf:"bytes,3,rep,name=clients,proto3" json:"clients,omitempty"`
+	// market ids as in the markets message, an element without market passes
+	Markets []int32 `protobuf:"varint,4,rep,packed,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (x *MessageFilter) Reset() {
+	*x = MessageFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageFilter) ProtoMessage() {}
+
+func (x *MessageFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageFilter.ProtoReflect.Descriptor instead.
+func (*MessageFilter) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageFilter) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *MessageFilter) GetSecurities() []*SecurityFilter {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *MessageFilter) GetClients() []string {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+func (x *MessageFilter) GetMarkets() []int32 {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+type DataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlowConsumerPolicy SlowConsumerPolicy `protobuf:"varint,1,opt,name=slow_consumer_policy,json=slowConsumerPolicy,proto3,enum=SlowConsumerPolicy" json:"slow_consumer_policy,omitempty"`
+	// 0 means the server queue size
+	MaxLag       uint32               `protobuf:"varint,2,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+	BlockTimeout *durationpb.Duration `protobuf:"bytes,3,opt,name=block_timeout,json=blockTimeout,proto3" json:"block_timeout,omitempty"`
+	// while the client is busy merge quotations and quotes of the same security
+	// into the latest state instead of sending every update
+	Conflate bool `protobuf:"varint,4,opt,name=conflate,proto3" json:"conflate,omitempty"`
+	// the first sequence to deliver, delivered_through + 1 of the last response
+	// received. Messages received by the server in the meantime are replayed from
+	// the journal, then the stream goes live. 0 starts with live messages.
+	ResumeFromSequence uint64         `protobuf:"varint,5,opt,name=resume_from_sequence,json=resumeFromSequence,proto3" json:"resume_from_sequence,omitempty"`
+	Filter             *MessageFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// identifies the client in Subscribe and Unsubscribe, its subscriptions are
+	// released when the last stream with the id ends
+	SubscriberId string `protobuf:"bytes,7,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"`
+	// a new stream starts with the markets, boards, candlekinds, securities,
+	// client, positions, orders and trades of the session followed by
+	// snapshot_end. Resumed streams get no snapshot.
+	SkipSnapshot bool `protobuf:"varint,8,opt,name=skip_snapshot,json=skipSnapshot,proto3" json:"skip_snapshot,omitempty"`
+}
+
+func (x *DataRequest) Reset() {
+	*x = DataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRequest) ProtoMessage() {}
+
+func (x *DataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRequest.ProtoReflect.Descriptor instead.
+func (*DataRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DataRequest) GetSlowConsumerPolicy() SlowConsumerPolicy {
+	if x != nil {
+		return x.SlowConsumerPolicy
+	}
+	return SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_OLDEST
+}
+
+func (x *DataRequest) GetMaxLag() uint32 {
+	if x != nil {
+		return x.MaxLag
+	}
+	return 0
+}
+
+func (x *DataRequest) GetBlockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.BlockTimeout
+	}
+	return nil
+}
+
+func (x *DataRequest) GetConflate() bool {
+	if x != nil {
+		return x.Conflate
+	}
+	return false
+}
+
+func (x *DataRequest) GetResumeFromSequence() uint64 {
+	if x != nil {
+		return x.ResumeFromSequence
+	}
+	return 0
+}
+
+func (x *DataRequest) GetFilter() *MessageFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *DataRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *DataRequest) GetSkipSnapshot() bool {
+	if x != nil {
+		return x.SkipSnapshot
+	}
+	return false
+}
+
+// Gap is sent in place of a message when the client has lost messages,
+// the client should resync its state.
+type Gap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Missed uint64 `protobuf:"varint,1,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (x *Gap) Reset() {
+	*x = Gap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gap) ProtoMessage() {}
+
+func (x *Gap) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gap.ProtoReflect.Descriptor instead.
+func (*Gap) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Gap) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+// SnapshotEnd separates the snapshot from the live messages.
+type SnapshotEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the snapshot holds the session state up to it
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Messages uint32 `protobuf:"varint,2,opt,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *SnapshotEnd) Reset() {
+	*x = SnapshotEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotEnd) ProtoMessage() {}
+
+func (x *SnapshotEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotEnd.ProtoReflect.Descriptor instead.
+func (*SnapshotEnd) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SnapshotEnd) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SnapshotEnd) GetMessages() uint32 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+type DataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Gap     *Gap   `protobuf:"bytes,2,opt,name=gap,proto3" json:"gap,omitempty"`
+	// server-assigned, monotonic across restarts while the journal is enabled.
+	// Trading events overtake market data and a conflated update replaces earlier
+	// ones, so live sequences may come out of order. Resume from delivered_through.
+	Sequence   uint64                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// root element of the message: quotes, orders, server_status...
+	Type        string       `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	SnapshotEnd *SnapshotEnd `protobuf:"bytes,6,opt,name=snapshot_end,json=snapshotEnd,proto3" json:"snapshot_end,omitempty"`
+	// the message is a part of the snapshot, sequence is the one of snapshot_end
+	Snapshot bool `protobuf:"varint,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// every message up to it has been sent to the stream or filtered out, the
+	// stream resumes from delivered_through + 1 without a loss. Messages lost by
+	// a slow client hold it back, a resume replays them from the journal.
+	DeliveredThrough uint64 `protobuf:"varint,8,opt,name=delivered_through,json=deliveredThrough,proto3" json:"delivered_through,omitempty"`
+}
+
+func (x *DataResponse) Reset() {
+	*x = DataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataResponse) ProtoMessage() {}
+
+func (x *DataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataResponse.ProtoReflect.Descriptor instead.
+func (*DataResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DataResponse) GetGap() *Gap {
+	if x != nil {
+		return x.Gap
+	}
+	return nil
+}
+
+func (x *DataResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DataResponse) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *DataResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DataResponse) GetSnapshotEnd() *SnapshotEnd {
+	if x != nil {
+		return x.SnapshotEnd
+	}
+	return nil
+}
+
+func (x *DataResponse) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *DataResponse) GetDeliveredThrough() uint64 {
+	if x != nil {
+		return x.DeliveredThrough
+	}
+	return 0
+}
+
+type SendCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendCommandRequest) Reset() {
+	*x = SendCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandRequest) ProtoMessage() {}
+
+func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SendCommandRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendCommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Code          uint64 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Success       bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	TransactionId int64  `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SendCommandResponse) Reset() {
+	*x = SendCommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandResponse) ProtoMessage() {}
+
+func (x *SendCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandResponse.ProtoReflect.Descriptor instead.
+func (*SendCommandResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendCommandResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendCommandResponse) GetCode() uint64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SendCommandResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendCommandResponse) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SendCommandResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ConnectProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SOCKS4, SOCKS5 or HTTP-CONNECT
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Addr     string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Port     uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Login    string `protobuf:"bytes,4,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ConnectProxy) Reset() {
+	*x = ConnectProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectProxy) ProtoMessage() {}
+
+func (x *ConnectProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectProxy.ProtoReflect.Descriptor instead.
+func (*ConnectProxy) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConnectProxy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConnectProxy) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ConnectProxy) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ConnectProxy) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ConnectProxy) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ConnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Host     string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port     uint32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	// ru or en
+	Language       string        `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Autopos        *bool         `protobuf:"varint,6,opt,name=autopos,proto3,oneof" json:"autopos,omitempty"`
+	MicexRegisters *bool         `protobuf:"varint,7,opt,name=micex_registers,json=micexRegisters,proto3,oneof" json:"micex_registers,omitempty"`
+	Milliseconds   *bool         `protobuf:"varint,8,opt,name=milliseconds,proto3,oneof" json:"milliseconds,omitempty"`
+	UtcTime        *bool         `protobuf:"varint,9,opt,name=utc_time,json=utcTime,proto3,oneof" json:"utc_time,omitempty"`
+	Proxy          *ConnectProxy `protobuf:"bytes,10,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// milliseconds
+	Rqdelay uint32 `protobuf:"varint,11,opt,name=rqdelay,proto3" json:"rqdelay,omitempty"`
+	// seconds
+	SessionTimeout uint32 `protobuf:"varint,12,opt,name=session_timeout,json=sessionTimeout,proto3" json:"session_timeout,omitempty"`
+	// seconds
+	RequestTimeout uint32 `protobuf:"varint,13,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	// seconds
+	PushULimits uint32 `protobuf:"varint,14,opt,name=push_u_limits,json=pushULimits,proto3" json:"push_u_limits,omitempty"`
+	// seconds
+	PushPosEquity uint32 `protobuf:"varint,15,opt,name=push_pos_equity,json=pushPosEquity,proto3" json:"push_pos_equity,omitempty"`
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRequest) ProtoMessage() {}
+
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConnectRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetAutopos() bool {
+	if x != nil && x.Autopos != nil {
+		return *x.Autopos
+	}
+	return false
+}
+
+func (x *ConnectRequest) GetMicexRegisters() bool {
+	if x != nil && x.MicexRegisters != nil {
+		return *x.MicexRegisters
+	}
+	return false
+}
+
+func (x *ConnectRequest) GetMilliseconds() bool {
+	if x != nil && x.Milliseconds != nil {
+		return *x.Milliseconds
+	}
+	return false
+}
+
+func (x *ConnectRequest) GetUtcTime() bool {
+	if x != nil && x.UtcTime != nil {
+		return *x.UtcTime
+	}
+	return false
+}
+
+func (x *ConnectRequest) GetProxy() *ConnectProxy {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *ConnectRequest) GetRqdelay() uint32 {
+	if x != nil {
+		return x.Rqdelay
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetSessionTimeout() uint32 {
+	if x != nil {
+		return x.SessionTimeout
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetRequestTimeout() uint32 {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetPushULimits() uint32 {
+	if x != nil {
+		return x.PushULimits
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetPushPosEquity() uint32 {
+	if x != nil {
+		return x.PushPosEquity
+	}
+	return 0
+}
+
+type ConnectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConnectResponse) Reset() {
+	*x = ConnectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectResponse) ProtoMessage() {}
+
+func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
+func (*ConnectResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{10}
+}
+
+type DisconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisconnectRequest) Reset() {
+	*x = DisconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectRequest) ProtoMessage() {}
+
+func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{11}
+}
+
+type DisconnectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisconnectResponse) Reset() {
+	*x = DisconnectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectResponse) ProtoMessage() {}
+
+func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{12}
+}
+
+type ConnectionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State ConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=ConnectionState" json:"state,omitempty"`
+	// error text for CONNECTION_STATE_ERROR
+	Error    string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ServerId int32                  `protobuf:"varint,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerTz string                 `protobuf:"bytes,4,opt,name=server_tz,json=serverTz,proto3" json:"server_tz,omitempty"`
+	Time     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	// equals to state when server_status repeats the current state
+	PreviousState ConnectionState `protobuf:"varint,6,opt,name=previous_state,json=previousState,proto3,enum=ConnectionState" json:"previous_state,omitempty"`
+}
+
+func (x *ConnectionStatus) Reset() {
+	*x = ConnectionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatus) ProtoMessage() {}
+
+func (x *ConnectionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatus.ProtoReflect.Descriptor instead.
+func (*ConnectionStatus) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConnectionStatus) GetState() ConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return ConnectionState_CONNECTION_STATE_UNKNOWN
+}
+
+func (x *ConnectionStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ConnectionStatus) GetServerId() int32 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *ConnectionStatus) GetServerTz() string {
+	if x != nil {
+		return x.ServerTz
+	}
+	return ""
+}
+
+func (x *ConnectionStatus) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ConnectionStatus) GetPreviousState() ConnectionState {
+	if x != nil {
+		return x.PreviousState
+	}
+	return ConnectionState_CONNECTION_STATE_UNKNOWN
+}
+
+type ServerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ask the connector for a fresh server_status instead of the last known one,
+	// FAILED_PRECONDITION unless the session is connected
+	Refresh bool `protobuf:"varint,1,opt,name=refresh,proto3" json:"refresh,omitempty"`
+}
+
+func (x *ServerStatusRequest) Reset() {
+	*x = ServerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStatusRequest) ProtoMessage() {}
+
+func (x *ServerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStatusRequest.ProtoReflect.Descriptor instead.
+func (*ServerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ServerStatusRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type WatchConnectionStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchConnectionStateRequest) Reset() {
+	*x = WatchConnectionStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchConnectionStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchConnectionStateRequest) ProtoMessage() {}
+
+func (x *WatchConnectionStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchConnectionStateRequest.ProtoReflect.Descriptor instead.
+func (*WatchConnectionStateRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{15}
+}
+
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// connector log verbosity: 1 minimum, 2 standard, 3 maximum
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetLogLevelRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{17}
+}
+
+type ReplayJournalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 replays from the oldest retained message
+	FromSequence uint64 `protobuf:"varint,1,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// 0 replays up to the last message journaled before the request
+	ToSequence uint64 `protobuf:"varint,2,opt,name=to_sequence,json=toSequence,proto3" json:"to_sequence,omitempty"`
+}
+
+func (x *ReplayJournalRequest) Reset() {
+	*x = ReplayJournalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayJournalRequest) ProtoMessage() {}
+
+func (x *ReplayJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayJournalRequest.ProtoReflect.Descriptor instead.
+func (*ReplayJournalRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplayJournalRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+func (x *ReplayJournalRequest) GetToSequence() uint64 {
+	if x != nil {
+		return x.ToSequence
+	}
+	return 0
+}
+
+type JournalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence   uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Message    string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *JournalRecord) Reset() {
+	*x = JournalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JournalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalRecord) ProtoMessage() {}
+
+func (x *JournalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalRecord.ProtoReflect.Descriptor instead.
+func (*JournalRecord) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *JournalRecord) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *JournalRecord) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *JournalRecord) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SecurityRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board   string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode string `protobuf:"bytes,2,opt,name=seccode,proto3" json:"seccode,omitempty"`
+}
+
+func (x *SecurityRef) Reset() {
+	*x = SecurityRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityRef) ProtoMessage() {}
+
+func (x *SecurityRef) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityRef.ProtoReflect.Descriptor instead.
+func (*SecurityRef) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SecurityRef) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *SecurityRef) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+type MarketDataSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     MarketDataKind `protobuf:"varint,1,opt,name=kind,proto3,enum=MarketDataKind" json:"kind,omitempty"`
+	Security *SecurityRef   `protobuf:"bytes,2,opt,name=security,proto3" json:"security,omitempty"`
+}
+
+func (x *MarketDataSubscription) Reset() {
+	*x = MarketDataSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDataSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDataSubscription) ProtoMessage() {}
+
+func (x *MarketDataSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDataSubscription.ProtoReflect.Descriptor instead.
+func (*MarketDataSubscription) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MarketDataSubscription) GetKind() MarketDataKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MarketDataKind_MARKET_DATA_KIND_UNSPECIFIED
+}
+
+func (x *MarketDataSubscription) GetSecurity() *SecurityRef {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+// every kind is applied to every security
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// subscriber_id of an open FetchResponseData stream
+	SubscriberId string           `protobuf:"bytes,1,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"`
+	Securities   []*SecurityRef   `protobuf:"bytes,2,rep,name=securities,proto3" json:"securities,omitempty"`
+	Kinds        []MarketDataKind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=MarketDataKind" json:"kinds,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SubscribeRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetSecurities() []*SecurityRef {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetKinds() []MarketDataKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// everything the subscriber holds after the request
+	Subscriptions []*MarketDataSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubscribeResponse) GetSubscriptions() []*MarketDataSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type UnsubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberId string           `protobuf:"bytes,1,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"`
+	Securities   []*SecurityRef   `protobuf:"bytes,2,rep,name=securities,proto3" json:"securities,omitempty"`
+	Kinds        []MarketDataKind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=MarketDataKind" json:"kinds,omitempty"`
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnsubscribeRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *UnsubscribeRequest) GetSecurities() []*SecurityRef {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *UnsubscribeRequest) GetKinds() []MarketDataKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type UnsubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*MarketDataSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *UnsubscribeResponse) Reset() {
+	*x = UnsubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnsubscribeResponse) GetSubscriptions() []*MarketDataSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type MarketDataStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// empty streams every security
+	Securities []*SecurityRef `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
+	// subscribe to the securities for the life of the stream, shared with Subscribe
+	Subscribe          bool               `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	SlowConsumerPolicy SlowConsumerPolicy `protobuf:"varint,3,opt,name=slow_consumer_policy,json=slowConsumerPolicy,proto3,enum=SlowConsumerPolicy" json:"slow_consumer_policy,omitempty"`
+	MaxLag             uint32             `protobuf:"varint,4,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+}
+
+func (x *MarketDataStreamRequest) Reset() {
+	*x = MarketDataStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketDataStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDataStreamRequest) ProtoMessage() {}
+
+func (x *MarketDataStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDataStreamRequest.ProtoReflect.Descriptor instead.
+func (*MarketDataStreamRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MarketDataStreamRequest) GetSecurities() []*SecurityRef {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *MarketDataStreamRequest) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+func (x *MarketDataStreamRequest) GetSlowConsumerPolicy() SlowConsumerPolicy {
+	if x != nil {
+		return x.SlowConsumerPolicy
+	}
+	return SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_OLDEST
+}
+
+func (x *MarketDataStreamRequest) GetMaxLag() uint32 {
+	if x != nil {
+		return x.MaxLag
+	}
+	return 0
+}
+
+// Quotation is an incremental update, absent fields did not change. Decimals
+// are strings to keep them exact.
+type Quotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secid           int32                  `protobuf:"varint,1,opt,name=secid,proto3" json:"secid,omitempty"`
+	Board           string                 `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode         string                 `protobuf:"bytes,3,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	Last            *string                `protobuf:"bytes,4,opt,name=last,proto3,oneof" json:"last,omitempty"`
+	Quantity        *int64                 `protobuf:"varint,5,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	Bid             *string                `protobuf:"bytes,7,opt,name=bid,proto3,oneof" json:"bid,omitempty"`
+	BidDepth        *int64                 `protobuf:"varint,8,opt,name=bid_depth,json=bidDepth,proto3,oneof" json:"bid_depth,omitempty"`
+	BidDepthTotal   *int64                 `protobuf:"varint,9,opt,name=bid_depth_total,json=bidDepthTotal,proto3,oneof" json:"bid_depth_total,omitempty"`
+	NumBids         *int64                 `protobuf:"varint,10,opt,name=num_bids,json=numBids,proto3,oneof" json:"num_bids,omitempty"`
+	Offer           *string                `protobuf:"bytes,11,opt,name=offer,proto3,oneof" json:"offer,omitempty"`
+	OfferDepth      *int64                 `protobuf:"varint,12,opt,name=offer_depth,json=offerDepth,proto3,oneof" json:"offer_depth,omitempty"`
+	OfferDepthTotal *int64                 `protobuf:"varint,13,opt,name=offer_depth_total,json=offerDepthTotal,proto3,oneof" json:"offer_depth_total,omitempty"`
+	NumOffers       *int64                 `protobuf:"varint,14,opt,name=num_offers,json=numOffers,proto3,oneof" json:"num_offers,omitempty"`
+	Open            *string                `protobuf:"bytes,15,opt,name=open,proto3,oneof" json:"open,omitempty"`
+	High            *string                `protobuf:"bytes,16,opt,name=high,proto3,oneof" json:"high,omitempty"`
+	Low             *string                `protobuf:"bytes,17,opt,name=low,proto3,oneof" json:"low,omitempty"`
+	ClosePrice      *string                `protobuf:"bytes,18,opt,name=close_price,json=closePrice,proto3,oneof" json:"close_price,omitempty"`
+	Change          *string                `protobuf:"bytes,19,opt,name=change,proto3,oneof" json:"change,omitempty"`
+	Waprice         *string                `protobuf:"bytes,20,opt,name=waprice,proto3,oneof" json:"waprice,omitempty"`
+	NumTrades       *int64                 `protobuf:"varint,21,opt,name=num_trades,json=numTrades,proto3,oneof" json:"num_trades,omitempty"`
+	VolToday        *int64                 `protobuf:"varint,22,opt,name=vol_today,json=volToday,proto3,oneof" json:"vol_today,omitempty"`
+	ValToday        *string                `protobuf:"bytes,23,opt,name=val_today,json=valToday,proto3,oneof" json:"val_today,omitempty"`
+	OpenPositions   *int64                 `protobuf:"varint,24,opt,name=open_positions,json=openPositions,proto3,oneof" json:"open_positions,omitempty"`
+	// sequence of the callback message
+	Sequence   uint64                 `protobuf:"varint,25,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// callback messages lost right before this one, the state should be refreshed
+	Missed uint64 `protobuf:"varint,27,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (x *Quotation) Reset() {
+	*x = Quotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quotation) ProtoMessage() {}
+
+func (x *Quotation) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quotation.ProtoReflect.Descriptor instead.
+func (*Quotation) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Quotation) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *Quotation) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *Quotation) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *Quotation) GetLast() string {
+	if x != nil && x.Last != nil {
+		return *x.Last
+	}
+	return ""
+}
+
+func (x *Quotation) GetQuantity() int64 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+func (x *Quotation) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Quotation) GetBid() string {
+	if x != nil && x.Bid != nil {
+		return *x.Bid
+	}
+	return ""
+}
+
+func (x *Quotation) GetBidDepth() int64 {
+	if x != nil && x.BidDepth != nil {
+		return *x.BidDepth
+	}
+	return 0
+}
+
+func (x *Quotation) GetBidDepthTotal() int64 {
+	if x != nil && x.BidDepthTotal != nil {
+		return *x.BidDepthTotal
+	}
+	return 0
+}
+
+func (x *Quotation) GetNumBids() int64 {
+	if x != nil && x.NumBids != nil {
+		return *x.NumBids
+	}
+	return 0
+}
+
+func (x *Quotation) GetOffer() string {
+	if x != nil && x.Offer != nil {
+		return *x.Offer
+	}
+	return ""
+}
+
+func (x *Quotation) GetOfferDepth() int64 {
+	if x != nil && x.OfferDepth != nil {
+		return *x.OfferDepth
+	}
+	return 0
+}
+
+func (x *Quotation) GetOfferDepthTotal() int64 {
+	if x != nil && x.OfferDepthTotal != nil {
+		return *x.OfferDepthTotal
+	}
+	return 0
+}
+
+func (x *Quotation) GetNumOffers() int64 {
+	if x != nil && x.NumOffers != nil {
+		return *x.NumOffers
+	}
+	return 0
+}
+
+func (x *Quotation) GetOpen() string {
+	if x != nil && x.Open != nil {
+		return *x.Open
+	}
+	return ""
+}
+
+func (x *Quotation) GetHigh() string {
+	if x != nil && x.High != nil {
+		return *x.High
+	}
+	return ""
+}
+
+func (x *Quotation) GetLow() string {
+	if x != nil && x.Low != nil {
+		return *x.Low
+	}
+	return ""
+}
+
+func (x *Quotation) GetClosePrice() string {
+	if x != nil && x.ClosePrice != nil {
+		return *x.ClosePrice
+	}
+	return ""
+}
+
+func (x *Quotation) GetChange() string {
+	if x != nil && x.Change != nil {
+		return *x.Change
+	}
+	return ""
+}
+
+func (x *Quotation) GetWaprice() string {
+	if x != nil && x.Waprice != nil {
+		return *x.Waprice
+	}
+	return ""
+}
+
+func (x *Quotation) GetNumTrades() int64 {
+	if x != nil && x.NumTrades != nil {
+		return *x.NumTrades
+	}
+	return 0
+}
+
+func (x *Quotation) GetVolToday() int64 {
+	if x != nil && x.VolToday != nil {
+		return *x.VolToday
+	}
+	return 0
+}
+
+func (x *Quotation) GetValToday() string {
+	if x != nil && x.ValToday != nil {
+		return *x.ValToday
+	}
+	return ""
+}
+
+func (x *Quotation) GetOpenPositions() int64 {
+	if x != nil && x.OpenPositions != nil {
+		return *x.OpenPositions
+	}
+	return 0
+}
+
+func (x *Quotation) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Quotation) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *Quotation) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+type MarketTrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secid        int32                  `protobuf:"varint,1,opt,name=secid,proto3" json:"secid,omitempty"`
+	Board        string                 `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode      string                 `protobuf:"bytes,3,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	TradeNo      int64                  `protobuf:"varint,4,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"`
+	Time         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	Price        string                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity     int64                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Buysell      BuySell                `protobuf:"varint,8,opt,name=buysell,proto3,enum=BuySell" json:"buysell,omitempty"`
+	OpenInterest int64                  `protobuf:"varint,9,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	Period       string                 `protobuf:"bytes,10,opt,name=period,proto3" json:"period,omitempty"`
+	Sequence     uint64                 `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ReceivedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Missed       uint64                 `protobuf:"varint,13,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (x *MarketTrade) Reset() {
+	*x = MarketTrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketTrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketTrade) ProtoMessage() {}
+
+func (x *MarketTrade) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketTrade.ProtoReflect.Descriptor instead.
+func (*MarketTrade) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MarketTrade) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *MarketTrade) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *MarketTrade) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *MarketTrade) GetTradeNo() int64 {
+	if x != nil {
+		return x.TradeNo
+	}
+	return 0
+}
+
+func (x *MarketTrade) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *MarketTrade) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *MarketTrade) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MarketTrade) GetBuysell() BuySell {
+	if x != nil {
+		return x.Buysell
+	}
+	return BuySell_BUY_SELL_UNSPECIFIED
+}
+
+func (x *MarketTrade) GetOpenInterest() int64 {
+	if x != nil {
+		return x.OpenInterest
+	}
+	return 0
+}
+
+func (x *MarketTrade) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *MarketTrade) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MarketTrade) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *MarketTrade) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+type OrderBookLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price  string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Yield  *int64 `protobuf:"varint,3,opt,name=yield,proto3,oneof" json:"yield,omitempty"`
+	// -1 removes the side of the level, an absent side did not change
+	Buy  *int64 `protobuf:"varint,4,opt,name=buy,proto3,oneof" json:"buy,omitempty"`
+	Sell *int64 `protobuf:"varint,5,opt,name=sell,proto3,oneof" json:"sell,omitempty"`
+}
+
+func (x *OrderBookLevel) Reset() {
+	*x = OrderBookLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBookLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookLevel) ProtoMessage() {}
+
+func (x *OrderBookLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookLevel.ProtoReflect.Descriptor instead.
+func (*OrderBookLevel) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OrderBookLevel) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *OrderBookLevel) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OrderBookLevel) GetYield() int64 {
+	if x != nil && x.Yield != nil {
+		return *x.Yield
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) GetBuy() int64 {
+	if x != nil && x.Buy != nil {
+		return *x.Buy
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) GetSell() int64 {
+	if x != nil && x.Sell != nil {
+		return *x.Sell
+	}
+	return 0
+}
+
+// OrderBookUpdate carries the changed levels of one security from a quotes message.
+type OrderBookUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secid      int32                  `protobuf:"varint,1,opt,name=secid,proto3" json:"secid,omitempty"`
+	Board      string                 `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode    string                 `protobuf:"bytes,3,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	Levels     []*OrderBookLevel      `protobuf:"bytes,4,rep,name=levels,proto3" json:"levels,omitempty"`
+	Sequence   uint64                 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Missed     uint64                 `protobuf:"varint,7,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (x *OrderBookUpdate) Reset() {
+	*x = OrderBookUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBookUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookUpdate) ProtoMessage() {}
+
+func (x *OrderBookUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookUpdate.ProtoReflect.Descriptor instead.
+func (*OrderBookUpdate) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OrderBookUpdate) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *OrderBookUpdate) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *OrderBookUpdate) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *OrderBookUpdate) GetLevels() []*OrderBookLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *OrderBookUpdate) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderBookUpdate) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *OrderBookUpdate) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Market) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Market) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Board struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Market int32  `protobuf:"varint,3,opt,name=market,proto3" json:"market,omitempty"`
+	Type   int32  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Board) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Board) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Board) GetMarket() int32 {
+	if x != nil {
+		return x.Market
+	}
+	return 0
+}
+
+func (x *Board) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+type CandleKind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seconds
+	Period int32  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CandleKind) Reset() {
+	*x = CandleKind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandleKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandleKind) ProtoMessage() {}
+
+func (x *CandleKind) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandleKind.ProtoReflect.Descriptor instead.
+func (*CandleKind) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CandleKind) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CandleKind) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *CandleKind) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Security is the last known state of a security, sec_info and sec_info_upd
+// changes included. Decimals are strings to keep them exact.
+type Security struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secid      int32  `protobuf:"varint,1,opt,name=secid,proto3" json:"secid,omitempty"`
+	Active     bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Seccode    string `protobuf:"bytes,3,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	Instrclass string `protobuf:"bytes,4,opt,name=instrclass,proto3" json:"instrclass,omitempty"`
+	Board      string `protobuf:"bytes,5,opt,name=board,proto3" json:"board,omitempty"`
+	Market     int32  `protobuf:"varint,6,opt,name=market,proto3" json:"market,omitempty"`
+	Currency   string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Shortname  string `protobuf:"bytes,8,opt,name=shortname,proto3" json:"shortname,omitempty"`
+	Decimals   int32  `protobuf:"varint,9,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Minstep    string `protobuf:"bytes,10,opt,name=minstep,proto3" json:"minstep,omitempty"`
+	Lotsize    int64  `protobuf:"varint,11,opt,name=lotsize,proto3" json:"lotsize,omitempty"`
+	Lotdivider int64  `protobuf:"varint,12,opt,name=lotdivider,proto3" json:"lotdivider,omitempty"`
+	PointCost  string `protobuf:"bytes,13,opt,name=point_cost,json=pointCost,proto3" json:"point_cost,omitempty"`
+	UseCredit  bool   `protobuf:"varint,14,opt,name=use_credit,json=useCredit,proto3" json:"use_credit,omitempty"`
+	ByMarket   bool   `protobuf:"varint,15,opt,name=by_market,json=byMarket,proto3" json:"by_market,omitempty"`
+	NoSplit    bool   `protobuf:"varint,16,opt,name=no_split,json=noSplit,proto3" json:"no_split,omitempty"`
+	Fok        bool   `protobuf:"varint,17,opt,name=fok,proto3" json:"fok,omitempty"`
+	Ioc        bool   `protobuf:"varint,18,opt,name=ioc,proto3" json:"ioc,omitempty"`
+	Sectype    string `protobuf:"bytes,19,opt,name=sectype,proto3" json:"sectype,omitempty"`
+	SecTz      string `protobuf:"bytes,20,opt,name=sec_tz,json=secTz,proto3" json:"sec_tz,omitempty"`
+	Quotestype int32  `protobuf:"varint,21,opt,name=quotestype,proto3" json:"quotestype,omitempty"`
+	Mic        string `protobuf:"bytes,22,opt,name=mic,proto3" json:"mic,omitempty"`
+	// full name, known once sec_info is received
+	Name     string  `protobuf:"bytes,23,opt,name=name,proto3" json:"name,omitempty"`
+	MinPrice *string `protobuf:"bytes,24,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice *string `protobuf:"bytes,25,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	BgoC     *string `protobuf:"bytes,26,opt,name=bgo_c,json=bgoC,proto3,oneof" json:"bgo_c,omitempty"`
+	BgoNc    *string `protobuf:"bytes,27,opt,name=bgo_nc,json=bgoNc,proto3,oneof" json:"bgo_nc,omitempty"`
+	BgoBuy   *string `protobuf:"bytes,28,opt,name=bgo_buy,json=bgoBuy,proto3,oneof" json:"bgo_buy,omitempty"`
+}
+
+func (x *Security) Reset() {
+	*x = Security{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Security) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Security) ProtoMessage() {}
+
+func (x *Security) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Security.ProtoReflect.Descriptor instead.
+func (*Security) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Security) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *Security) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Security) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *Security) GetInstrclass() string {
+	if x != nil {
+		return x.Instrclass
+	}
+	return ""
+}
+
+func (x *Security) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *Security) GetMarket() int32 {
+	if x != nil {
+		return x.Market
+	}
+	return 0
+}
+
+func (x *Security) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Security) GetShortname() string {
+	if x != nil {
+		return x.Shortname
+	}
+	return ""
+}
+
+func (x *Security) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Security) GetMinstep() string {
+	if x != nil {
+		return x.Minstep
+	}
+	return ""
+}
+
+func (x *Security) GetLotsize() int64 {
+	if x != nil {
+		return x.Lotsize
+	}
+	return 0
+}
+
+func (x *Security) GetLotdivider() int64 {
+	if x != nil {
+		return x.Lotdivider
+	}
+	return 0
+}
+
+func (x *Security) GetPointCost() string {
+	if x != nil {
+		return x.PointCost
+	}
+	return ""
+}
+
+func (x *Security) GetUseCredit() bool {
+	if x != nil {
+		return x.UseCredit
+	}
+	return false
+}
+
+func (x *Security) GetByMarket() bool {
+	if x != nil {
+		return x.ByMarket
+	}
+	return false
+}
+
+func (x *Security) GetNoSplit() bool {
+	if x != nil {
+		return x.NoSplit
+	}
+	return false
+}
+
+func (x *Security) GetFok() bool {
+	if x != nil {
+		return x.Fok
+	}
+	return false
+}
+
+func (x *Security) GetIoc() bool {
+	if x != nil {
+		return x.Ioc
+	}
+	return false
+}
+
+func (x *Security) GetSectype() string {
+	if x != nil {
+		return x.Sectype
+	}
+	return ""
+}
+
+func (x *Security) GetSecTz() string {
+	if x != nil {
+		return x.SecTz
+	}
+	return ""
+}
+
+func (x *Security) GetQuotestype() int32 {
+	if x != nil {
+		return x.Quotestype
+	}
+	return 0
+}
+
+func (x *Security) GetMic() string {
+	if x != nil {
+		return x.Mic
+	}
+	return ""
+}
+
+func (x *Security) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Security) GetMinPrice() string {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return ""
+}
+
+func (x *Security) GetMaxPrice() string {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return ""
+}
+
+func (x *Security) GetBgoC() string {
+	if x != nil && x.BgoC != nil {
+		return *x.BgoC
+	}
+	return ""
+}
+
+func (x *Security) GetBgoNc() string {
+	if x != nil && x.BgoNc != nil {
+		return *x.BgoNc
+	}
+	return ""
+}
+
+func (x *Security) GetBgoBuy() string {
+	if x != nil && x.BgoBuy != nil {
+		return *x.BgoBuy
+	}
+	return ""
+}
+
+// every set filter must match
+type ListSecuritiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Markets  []int32  `protobuf:"varint,1,rep,packed,name=markets,proto3" json:"markets,omitempty"`
+	Boards   []string `protobuf:"bytes,2,rep,name=boards,proto3" json:"boards,omitempty"`
+	Sectypes []string `protobuf:"bytes,3,rep,name=sectypes,proto3" json:"sectypes,omitempty"`
+}
+
+func (x *ListSecuritiesRequest) Reset() {
+	*x = ListSecuritiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecuritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecuritiesRequest) ProtoMessage() {}
+
+func (x *ListSecuritiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecuritiesRequest.ProtoReflect.Descriptor instead.
+func (*ListSecuritiesRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListSecuritiesRequest) GetMarkets() []int32 {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *ListSecuritiesRequest) GetBoards() []string {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+func (x *ListSecuritiesRequest) GetSectypes() []string {
+	if x != nil {
+		return x.Sectypes
+	}
+	return nil
+}
+
+type ListSecuritiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Securities  []*Security   `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
+	Markets     []*Market     `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets,omitempty"`
+	Boards      []*Board      `protobuf:"bytes,3,rep,name=boards,proto3" json:"boards,omitempty"`
+	CandleKinds []*CandleKind `protobuf:"bytes,4,rep,name=candle_kinds,json=candleKinds,proto3" json:"candle_kinds,omitempty"`
+}
+
+func (x *ListSecuritiesResponse) Reset() {
+	*x = ListSecuritiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecuritiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecuritiesResponse) ProtoMessage() {}
+
+func (x *ListSecuritiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecuritiesResponse.ProtoReflect.Descriptor instead.
+func (*ListSecuritiesResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListSecuritiesResponse) GetSecurities() []*Security {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *ListSecuritiesResponse) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *ListSecuritiesResponse) GetBoards() []*Board {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+func (x *ListSecuritiesResponse) GetCandleKinds() []*CandleKind {
+	if x != nil {
+		return x.CandleKinds
+	}
+	return nil
+}
+
+// secid wins over board and seccode
+type GetSecurityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secid   int32  `protobuf:"varint,1,opt,name=secid,proto3" json:"secid,omitempty"`
+	Board   string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode string `protobuf:"bytes,3,opt,name=seccode,proto3" json:"seccode,omitempty"`
+}
+
+func (x *GetSecurityRequest) Reset() {
+	*x = GetSecurityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecurityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityRequest) ProtoMessage() {}
+
+func (x *GetSecurityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSecurityRequest) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *GetSecurityRequest) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *GetSecurityRequest) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+type SearchSecuritiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// matched against seccode, short and full names ignoring case
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 0 means 50
+	Limit    uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Markets  []int32  `protobuf:"varint,3,rep,packed,name=markets,proto3" json:"markets,omitempty"`
+	Boards   []string `protobuf:"bytes,4,rep,name=boards,proto3" json:"boards,omitempty"`
+	Sectypes []string `protobuf:"bytes,5,rep,name=sectypes,proto3" json:"sectypes,omitempty"`
+}
+
+func (x *SearchSecuritiesRequest) Reset() {
+	*x = SearchSecuritiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSecuritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecuritiesRequest) ProtoMessage() {}
+
+func (x *SearchSecuritiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSecuritiesRequest.ProtoReflect.Descriptor instead.
+func (*SearchSecuritiesRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchSecuritiesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSecuritiesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchSecuritiesRequest) GetMarkets() []int32 {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *SearchSecuritiesRequest) GetBoards() []string {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+func (x *SearchSecuritiesRequest) GetSectypes() []string {
+	if x != nil {
+		return x.Sectypes
+	}
+	return nil
+}
+
+type SearchSecuritiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// best matches first
+	Securities []*Security `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
+}
+
+func (x *SearchSecuritiesResponse) Reset() {
+	*x = SearchSecuritiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSecuritiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecuritiesResponse) ProtoMessage() {}
+
+func (x *SearchSecuritiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSecuritiesResponse.ProtoReflect.Descriptor instead.
+func (*SearchSecuritiesResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchSecuritiesResponse) GetSecurities() []*Security {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+type BookLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price  string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Yield  *int64 `protobuf:"varint,3,opt,name=yield,proto3,oneof" json:"yield,omitempty"`
+	// 0 in a delta removes the level
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *BookLevel) Reset() {
+	*x = BookLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookLevel) ProtoMessage() {}
+
+func (x *BookLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookLevel.ProtoReflect.Descriptor instead.
+func (*BookLevel) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BookLevel) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *BookLevel) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BookLevel) GetYield() int64 {
+	if x != nil && x.Yield != nil {
+		return *x.Yield
+	}
+	return 0
+}
+
+func (x *BookLevel) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// OrderBook is the book rebuilt by the server from the quotes deltas.
+type OrderBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secid   int32  `protobuf:"varint,1,opt,name=secid,proto3" json:"secid,omitempty"`
+	Board   string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode string `protobuf:"bytes,3,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	// every change of the book increments it, 0 when nothing was received
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the best first
+	Bids      []*BookLevel           `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks      []*BookLevel           `protobuf:"bytes,6,rep,name=asks,proto3" json:"asks,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *OrderBook) Reset() {
+	*x = OrderBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBook) ProtoMessage() {}
+
+func (x *OrderBook) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBook.ProtoReflect.Descriptor instead.
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OrderBook) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *OrderBook) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *OrderBook) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *OrderBook) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderBook) GetBids() []*BookLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderBook) GetAsks() []*BookLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *OrderBook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type OrderBookDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secid   int32  `protobuf:"varint,1,opt,name=secid,proto3" json:"secid,omitempty"`
+	Board   string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode string `protobuf:"bytes,3,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	// the previous sequence of the book + 1, otherwise the book is out of sync
+	Sequence  uint64                 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Bids      []*BookLevel           `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks      []*BookLevel           `protobuf:"bytes,6,rep,name=asks,proto3" json:"asks,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *OrderBookDelta) Reset() {
+	*x = OrderBookDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBookDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookDelta) ProtoMessage() {}
+
+func (x *OrderBookDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookDelta.ProtoReflect.Descriptor instead.
+func (*OrderBookDelta) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OrderBookDelta) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *OrderBookDelta) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *OrderBookDelta) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *OrderBookDelta) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderBookDelta) GetBids() []*BookLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderBookDelta) GetAsks() []*BookLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *OrderBookDelta) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetOrderBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Security *SecurityRef `protobuf:"bytes,1,opt,name=security,proto3" json:"security,omitempty"`
+	// levels of every side, 0 returns the whole book
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (x *GetOrderBookRequest) Reset() {
+	*x = GetOrderBookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookRequest) ProtoMessage() {}
+
+func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderBookRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetOrderBookRequest) GetSecurity() *SecurityRef {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *GetOrderBookRequest) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type WatchOrderBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Securities []*SecurityRef `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
+	// subscribe to the quotes for the life of the stream, shared with Subscribe
+	Subscribe bool `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+}
+
+func (x *WatchOrderBookRequest) Reset() {
+	*x = WatchOrderBookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOrderBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderBookRequest) ProtoMessage() {}
+
+func (x *WatchOrderBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderBookRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderBookRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WatchOrderBookRequest) GetSecurities() []*SecurityRef {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *WatchOrderBookRequest) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+// OrderBookEvent carries either a full book or a delta to apply to it. A book
+// comes first for every security and again whenever the stream lost deltas or
+// the book was emptied.
+type OrderBookEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *OrderBook      `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Delta    *OrderBookDelta `protobuf:"bytes,2,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *OrderBookEvent) Reset() {
+	*x = OrderBookEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookEvent) ProtoMessage() {}
+
+func (x *OrderBookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookEvent.ProtoReflect.Descriptor instead.
+func (*OrderBookEvent) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *OrderBookEvent) GetSnapshot() *OrderBook {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *OrderBookEvent) GetDelta() *OrderBookDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+type Validity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind ValidityKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=ValidityKind" json:"kind,omitempty"`
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Validity) Reset() {
+	*x = Validity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Validity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validity) ProtoMessage() {}
+
+func (x *Validity) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validity.ProtoReflect.Descriptor instead.
+func (*Validity) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Validity) GetKind() ValidityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ValidityKind_VALIDITY_KIND_UNSPECIFIED
+}
+
+func (x *Validity) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// prices are decimal strings, exactly one of client and union is required
+type PlaceOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Security *SecurityRef `protobuf:"bytes,1,opt,name=security,proto3" json:"security,omitempty"`
+	Client   string       `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Union    string       `protobuf:"bytes,3,opt,name=union,proto3" json:"union,omitempty"`
+	Buysell  BuySell      `protobuf:"varint,4,opt,name=buysell,proto3,enum=BuySell" json:"buysell,omitempty"`
+	Quantity int64        `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// required unless by_market
+	Price     string                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	ByMarket  bool                   `protobuf:"varint,7,opt,name=by_market,json=byMarket,proto3" json:"by_market,omitempty"`
+	Hidden    int64                  `protobuf:"varint,8,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	Brokerref string                 `protobuf:"bytes,9,opt,name=brokerref,proto3" json:"brokerref,omitempty"`
+	Unfilled  OrderUnfilled          `protobuf:"varint,10,opt,name=unfilled,proto3,enum=OrderUnfilled" json:"unfilled,omitempty"`
+	UseCredit bool                   `protobuf:"varint,11,opt,name=use_credit,json=useCredit,proto3" json:"use_credit,omitempty"`
+	NoSplit   bool                   `protobuf:"varint,12,opt,name=no_split,json=noSplit,proto3" json:"no_split,omitempty"`
+	Expdate   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expdate,proto3" json:"expdate,omitempty"`
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PlaceOrderRequest) GetSecurity() *SecurityRef {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *PlaceOrderRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetUnion() string {
+	if x != nil {
+		return x.Union
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetBuysell() BuySell {
+	if x != nil {
+		return x.Buysell
+	}
+	return BuySell_BUY_SELL_UNSPECIFIED
+}
+
+func (x *PlaceOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetByMarket() bool {
+	if x != nil {
+		return x.ByMarket
+	}
+	return false
+}
+
+func (x *PlaceOrderRequest) GetHidden() int64 {
+	if x != nil {
+		return x.Hidden
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetBrokerref() string {
+	if x != nil {
+		return x.Brokerref
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetUnfilled() OrderUnfilled {
+	if x != nil {
+		return x.Unfilled
+	}
+	return OrderUnfilled_ORDER_UNFILLED_PUT_IN_QUEUE
+}
+
+func (x *PlaceOrderRequest) GetUseCredit() bool {
+	if x != nil {
+		return x.UseCredit
+	}
+	return false
+}
+
+func (x *PlaceOrderRequest) GetNoSplit() bool {
+	if x != nil {
+		return x.NoSplit
+	}
+	return false
+}
+
+func (x *PlaceOrderRequest) GetExpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expdate
+	}
+	return nil
+}
+
+type StopLoss struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivationPrice string `protobuf:"bytes,1,opt,name=activation_price,json=activationPrice,proto3" json:"activation_price,omitempty"`
+	// required unless by_market
+	OrderPrice string `protobuf:"bytes,2,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+	ByMarket   bool   `protobuf:"varint,3,opt,name=by_market,json=byMarket,proto3" json:"by_market,omitempty"`
+	Quantity   int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UseCredit  bool   `protobuf:"varint,5,opt,name=use_credit,json=useCredit,proto3" json:"use_credit,omitempty"`
+	// minutes
+	GuardTime int32  `protobuf:"varint,6,opt,name=guard_time,json=guardTime,proto3" json:"guard_time,omitempty"`
+	Brokerref string `protobuf:"bytes,7,opt,name=brokerref,proto3" json:"brokerref,omitempty"`
+}
+
+func (x *StopLoss) Reset() {
+	*x = StopLoss{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopLoss) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopLoss) ProtoMessage() {}
+
+func (x *StopLoss) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopLoss.ProtoReflect.Descriptor instead.
+func (*StopLoss) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StopLoss) GetActivationPrice() string {
+	if x != nil {
+		return x.ActivationPrice
+	}
+	return ""
+}
+
+func (x *StopLoss) GetOrderPrice() string {
+	if x != nil {
+		return x.OrderPrice
+	}
+	return ""
+}
+
+func (x *StopLoss) GetByMarket() bool {
+	if x != nil {
+		return x.ByMarket
+	}
+	return false
+}
+
+func (x *StopLoss) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StopLoss) GetUseCredit() bool {
+	if x != nil {
+		return x.UseCredit
+	}
+	return false
+}
+
+func (x *StopLoss) GetGuardTime() int32 {
+	if x != nil {
+		return x.GuardTime
+	}
+	return 0
+}
+
+func (x *StopLoss) GetBrokerref() string {
+	if x != nil {
+		return x.Brokerref
+	}
+	return ""
+}
+
+type TakeProfit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivationPrice string `protobuf:"bytes,1,opt,name=activation_price,json=activationPrice,proto3" json:"activation_price,omitempty"`
+	Quantity        int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UseCredit       bool   `protobuf:"varint,3,opt,name=use_credit,json=useCredit,proto3" json:"use_credit,omitempty"`
+	// minutes
+	GuardTime  int32  `protobuf:"varint,4,opt,name=guard_time,json=guardTime,proto3" json:"guard_time,omitempty"`
+	Brokerref  string `protobuf:"bytes,5,opt,name=brokerref,proto3" json:"brokerref,omitempty"`
+	Correction string `protobuf:"bytes,6,opt,name=correction,proto3" json:"correction,omitempty"`
+	Spread     string `protobuf:"bytes,7,opt,name=spread,proto3" json:"spread,omitempty"`
+	ByMarket   bool   `protobuf:"varint,8,opt,name=by_market,json=byMarket,proto3" json:"by_market,omitempty"`
+}
+
+func (x *TakeProfit) Reset() {
+	*x = TakeProfit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TakeProfit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeProfit) ProtoMessage() {}
+
+func (x *TakeProfit) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeProfit.ProtoReflect.Descriptor instead.
+func (*TakeProfit) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TakeProfit) GetActivationPrice() string {
+	if x != nil {
+		return x.ActivationPrice
+	}
+	return ""
+}
+
+func (x *TakeProfit) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TakeProfit) GetUseCredit() bool {
+	if x != nil {
+		return x.UseCredit
+	}
+	return false
+}
+
+func (x *TakeProfit) GetGuardTime() int32 {
+	if x != nil {
+		return x.GuardTime
+	}
+	return 0
+}
+
+func (x *TakeProfit) GetBrokerref() string {
+	if x != nil {
+		return x.Brokerref
+	}
+	return ""
+}
+
+func (x *TakeProfit) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+func (x *TakeProfit) GetSpread() string {
+	if x != nil {
+		return x.Spread
+	}
+	return ""
+}
+
+func (x *TakeProfit) GetByMarket() bool {
+	if x != nil {
+		return x.ByMarket
+	}
+	return false
+}
+
+// at least one of stop_loss and take_profit is required
+type PlaceStopOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Security      *SecurityRef           `protobuf:"bytes,1,opt,name=security,proto3" json:"security,omitempty"`
+	Client        string                 `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Union         string                 `protobuf:"bytes,3,opt,name=union,proto3" json:"union,omitempty"`
+	Buysell       BuySell                `protobuf:"varint,4,opt,name=buysell,proto3,enum=BuySell" json:"buysell,omitempty"`
+	LinkedOrderNo int64                  `protobuf:"varint,5,opt,name=linked_order_no,json=linkedOrderNo,proto3" json:"linked_order_no,omitempty"`
+	ValidFor      *Validity              `protobuf:"bytes,6,opt,name=valid_for,json=validFor,proto3" json:"valid_for,omitempty"`
+	Expdate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expdate,proto3" json:"expdate,omitempty"`
+	StopLoss      *StopLoss              `protobuf:"bytes,8,opt,name=stop_loss,json=stopLoss,proto3" json:"stop_loss,omitempty"`
+	TakeProfit    *TakeProfit            `protobuf:"bytes,9,opt,name=take_profit,json=takeProfit,proto3" json:"take_profit,omitempty"`
+}
+
+func (x *PlaceStopOrderRequest) Reset() {
+	*x = PlaceStopOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceStopOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceStopOrderRequest) ProtoMessage() {}
+
+func (x *PlaceStopOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceStopOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceStopOrderRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PlaceStopOrderRequest) GetSecurity() *SecurityRef {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *PlaceStopOrderRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *PlaceStopOrderRequest) GetUnion() string {
+	if x != nil {
+		return x.Union
+	}
+	return ""
+}
+
+func (x *PlaceStopOrderRequest) GetBuysell() BuySell {
+	if x != nil {
+		return x.Buysell
+	}
+	return BuySell_BUY_SELL_UNSPECIFIED
+}
+
+func (x *PlaceStopOrderRequest) GetLinkedOrderNo() int64 {
+	if x != nil {
+		return x.LinkedOrderNo
+	}
+	return 0
+}
+
+func (x *PlaceStopOrderRequest) GetValidFor() *Validity {
+	if x != nil {
+		return x.ValidFor
+	}
+	return nil
+}
+
+func (x *PlaceStopOrderRequest) GetExpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expdate
+	}
+	return nil
+}
+
+func (x *PlaceStopOrderRequest) GetStopLoss() *StopLoss {
+	if x != nil {
+		return x.StopLoss
+	}
+	return nil
+}
+
+func (x *PlaceStopOrderRequest) GetTakeProfit() *TakeProfit {
+	if x != nil {
+		return x.TakeProfit
+	}
+	return nil
+}
+
+type PlaceConditionalOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Security *SecurityRef `protobuf:"bytes,1,opt,name=security,proto3" json:"security,omitempty"`
+	Client   string       `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Union    string       `protobuf:"bytes,3,opt,name=union,proto3" json:"union,omitempty"`
+	Buysell  BuySell      `protobuf:"varint,4,opt,name=buysell,proto3,enum=BuySell" json:"buysell,omitempty"`
+	Quantity int64        `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// required unless by_market
+	Price     string   `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	ByMarket  bool     `protobuf:"varint,7,opt,name=by_market,json=byMarket,proto3" json:"by_market,omitempty"`
+	Hidden    int64    `protobuf:"varint,8,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	Brokerref string   `protobuf:"bytes,9,opt,name=brokerref,proto3" json:"brokerref,omitempty"`
+	CondType  CondType `protobuf:"varint,10,opt,name=cond_type,json=condType,proto3,enum=CondType" json:"cond_type,omitempty"`
+	// a price, or a time in the connector format for COND_TYPE_TIME
+	CondValue  string    `protobuf:"bytes,11,opt,name=cond_value,json=condValue,proto3" json:"cond_value,omitempty"`
+	ValidAfter *Validity `protobuf:"bytes,12,opt,name=valid_after,json=validAfter,proto3" json:"valid_after,omitempty"`
+	// VALIDITY_KIND_NOW is not allowed
+	ValidBefore *Validity              `protobuf:"bytes,13,opt,name=valid_before,json=validBefore,proto3" json:"valid_before,omitempty"`
+	UseCredit   bool                   `protobuf:"varint,14,opt,name=use_credit,json=useCredit,proto3" json:"use_credit,omitempty"`
+	NoSplit     bool                   `protobuf:"varint,15,opt,name=no_split,json=noSplit,proto3" json:"no_split,omitempty"`
+	Expdate     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=expdate,proto3" json:"expdate,omitempty"`
+	WithinPos   bool                   `protobuf:"varint,17,opt,name=within_pos,json=withinPos,proto3" json:"within_pos,omitempty"`
+}
+
+func (x *PlaceConditionalOrderRequest) Reset() {
+	*x = PlaceConditionalOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceConditionalOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceConditionalOrderRequest) ProtoMessage() {}
+
+func (x *PlaceConditionalOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceConditionalOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PlaceConditionalOrderRequest) GetSecurity() *SecurityRef {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *PlaceConditionalOrderRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *PlaceConditionalOrderRequest) GetUnion() string {
+	if x != nil {
+		return x.Union
+	}
+	return ""
+}
+
+func (x *PlaceConditionalOrderRequest) GetBuysell() BuySell {
+	if x != nil {
+		return x.Buysell
+	}
+	return BuySell_BUY_SELL_UNSPECIFIED
+}
+
+func (x *PlaceConditionalOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PlaceConditionalOrderRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PlaceConditionalOrderRequest) GetByMarket() bool {
+	if x != nil {
+		return x.ByMarket
+	}
+	return false
+}
+
+func (x *PlaceConditionalOrderRequest) GetHidden() int64 {
+	if x != nil {
+		return x.Hidden
+	}
+	return 0
+}
+
+func (x *PlaceConditionalOrderRequest) GetBrokerref() string {
+	if x != nil {
+		return x.Brokerref
+	}
+	return ""
+}
+
+func (x *PlaceConditionalOrderRequest) GetCondType() CondType {
+	if x != nil {
+		return x.CondType
+	}
+	return CondType_COND_TYPE_UNSPECIFIED
+}
+
+func (x *PlaceConditionalOrderRequest) GetCondValue() string {
+	if x != nil {
+		return x.CondValue
+	}
+	return ""
+}
+
+func (x *PlaceConditionalOrderRequest) GetValidAfter() *Validity {
+	if x != nil {
+		return x.ValidAfter
+	}
+	return nil
+}
+
+func (x *PlaceConditionalOrderRequest) GetValidBefore() *Validity {
+	if x != nil {
+		return x.ValidBefore
+	}
+	return nil
+}
+
+func (x *PlaceConditionalOrderRequest) GetUseCredit() bool {
+	if x != nil {
+		return x.UseCredit
+	}
+	return false
+}
+
+func (x *PlaceConditionalOrderRequest) GetNoSplit() bool {
+	if x != nil {
+		return x.NoSplit
+	}
+	return false
+}
+
+func (x *PlaceConditionalOrderRequest) GetExpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expdate
+	}
+	return nil
+}
+
+func (x *PlaceConditionalOrderRequest) GetWithinPos() bool {
+	if x != nil {
+		return x.WithinPos
+	}
+	return false
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId int64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// the transaction id is of a stop order
+	StopOrder bool `protobuf:"varint,2,opt,name=stop_order,json=stopOrder,proto3" json:"stop_order,omitempty"`
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CancelOrderRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *CancelOrderRequest) GetStopOrder() bool {
+	if x != nil {
+		return x.StopOrder
+	}
+	return false
+}
+
+type MoveOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId int64    `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Price         string   `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	MoveFlag      MoveFlag `protobuf:"varint,3,opt,name=move_flag,json=moveFlag,proto3,enum=MoveFlag" json:"move_flag,omitempty"`
+	// required unless MOVE_FLAG_KEEP_QUANTITY
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *MoveOrderRequest) Reset() {
+	*x = MoveOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveOrderRequest) ProtoMessage() {}
+
+func (x *MoveOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveOrderRequest.ProtoReflect.Descriptor instead.
+func (*MoveOrderRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MoveOrderRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *MoveOrderRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *MoveOrderRequest) GetMoveFlag() MoveFlag {
+	if x != nil {
+		return x.MoveFlag
+	}
+	return MoveFlag_MOVE_FLAG_KEEP_QUANTITY
+}
+
+func (x *MoveOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type OrderRejection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason  OrderRejectionReason `protobuf:"varint,1,opt,name=reason,proto3,enum=OrderRejectionReason" json:"reason,omitempty"`
+	Field   string               `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *OrderRejection) Reset() {
+	*x = OrderRejection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRejection) ProtoMessage() {}
+
+func (x *OrderRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRejection.ProtoReflect.Descriptor instead.
+func (*OrderRejection) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *OrderRejection) GetReason() OrderRejectionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return OrderRejectionReason_ORDER_REJECTION_REASON_UNSPECIFIED
+}
+
+func (x *OrderRejection) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *OrderRejection) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// OrderResponse is returned on success and attached to the status details of a
+// rejection, the transaction id is 0 then.
+type OrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the new order for place and move, the cancelled one for cancel
+	TransactionId int64           `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Rejection     *OrderRejection `protobuf:"bytes,2,opt,name=rejection,proto3" json:"rejection,omitempty"`
+}
+
+func (x *OrderResponse) Reset() {
+	*x = OrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderResponse) ProtoMessage() {}
+
+func (x *OrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
+func (*OrderResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *OrderResponse) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *OrderResponse) GetRejection() *OrderRejection {
+	if x != nil {
+		return x.Rejection
+	}
+	return nil
+}
+
+type OrderTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// as sent by Transaq
+	Status  string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Phase   OrderPhase             `protobuf:"varint,2,opt,name=phase,proto3,enum=OrderPhase" json:"phase,omitempty"`
+	Balance int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Result  string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *OrderTransition) Reset() {
+	*x = OrderTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTransition) ProtoMessage() {}
+
+func (x *OrderTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTransition.ProtoReflect.Descriptor instead.
+func (*OrderTransition) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *OrderTransition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetPhase() OrderPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return OrderPhase_ORDER_PHASE_PENDING
+}
+
+func (x *OrderTransition) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *OrderTransition) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type OrderFill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tradeno   int64                  `protobuf:"varint,1,opt,name=tradeno,proto3" json:"tradeno,omitempty"`
+	Price     string                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity  int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Value     string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Comission string                 `protobuf:"bytes,5,opt,name=comission,proto3" json:"comission,omitempty"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *OrderFill) Reset() {
+	*x = OrderFill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderFill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderFill) ProtoMessage() {}
+
+func (x *OrderFill) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderFill.ProtoReflect.Descriptor instead.
+func (*OrderFill) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *OrderFill) GetTradeno() int64 {
+	if x != nil {
+		return x.Tradeno
+	}
+	return 0
+}
+
+func (x *OrderFill) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *OrderFill) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderFill) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *OrderFill) GetComission() string {
+	if x != nil {
+		return x.Comission
+	}
+	return ""
+}
+
+func (x *OrderFill) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Order is an order or a stop order tracked by the server from the orders and
+// trades callbacks.
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId int64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	StopOrder     bool  `protobuf:"varint,2,opt,name=stop_order,json=stopOrder,proto3" json:"stop_order,omitempty"`
+	// for a stop order the number of the order it placed
+	Orderno int64   `protobuf:"varint,3,opt,name=orderno,proto3" json:"orderno,omitempty"`
+	Secid   int32   `protobuf:"varint,4,opt,name=secid,proto3" json:"secid,omitempty"`
+	Board   string  `protobuf:"bytes,5,opt,name=board,proto3" json:"board,omitempty"`
+	Seccode string  `protobuf:"bytes,6,opt,name=seccode,proto3" json:"seccode,omitempty"`
+	Client  string  `protobuf:"bytes,7,opt,name=client,proto3" json:"client,omitempty"`
+	Union   string  `protobuf:"bytes,8,opt,name=union,proto3" json:"union,omitempty"`
+	Buysell BuySell `protobuf:"varint,9,opt,name=buysell,proto3,enum=BuySell" json:"buysell,omitempty"`
+	// the activation price for a stop order
+	Price    string `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity int64  `protobuf:"varint,11,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Balance  int64  `protobuf:"varint,12,opt,name=balance,proto3" json:"balance,omitempty"`
+	// the sum of the fills quantities
+	Filled int64 `protobuf:"varint,13,opt,name=filled,proto3" json:"filled,omitempty"`
+	// as sent by Transaq
+	Status string     `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	Phase  OrderPhase `protobuf:"varint,15,opt,name=phase,proto3,enum=OrderPhase" json:"phase,omitempty"`
+	// no more updates follow
+	Terminal bool   `protobuf:"varint,16,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	Result   string `protobuf:"bytes,17,opt,name=result,proto3" json:"result,omitempty"`
+	// every change of the status or the balance, the first one included
+	Transitions []*OrderTransition `protobuf:"bytes,18,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	Fills       []*OrderFill       `protobuf:"bytes,19,rep,name=fills,proto3" json:"fills,omitempty"`
+	// of the last change, grows across every order
+	Sequence  uint64                 `protobuf:"varint,20,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Order) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Order) GetStopOrder() bool {
+	if x != nil {
+		return x.StopOrder
+	}
+	return false
+}
+
+func (x *Order) GetOrderno() int64 {
+	if x != nil {
+		return x.Orderno
+	}
+	return 0
+}
+
+func (x *Order) GetSecid() int32 {
+	if x != nil {
+		return x.Secid
+	}
+	return 0
+}
+
+func (x *Order) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *Order) GetSeccode() string {
+	if x != nil {
+		return x.Seccode
+	}
+	return ""
+}
+
+func (x *Order) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *Order) GetUnion() string {
+	if x != nil {
+		return x.Union
+	}
+	return ""
+}
+
+func (x *Order) GetBuysell() BuySell {
+	if x != nil {
+		return x.Buysell
+	}
+	return BuySell_BUY_SELL_UNSPECIFIED
+}
+
+func (x *Order) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Order) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Order) GetFilled() int64 {
+	if x != nil {
+		return x.Filled
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetPhase() OrderPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return OrderPhase_ORDER_PHASE_PENDING
+}
+
+func (x *Order) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+func (x *Order) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *Order) GetTransitions() []*OrderTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *Order) GetFills() []*OrderFill {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+func (x *Order) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId int64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetOrderRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// all securities when empty
+	Securities []*SecurityRef `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
+	// a client or a union
+	Client string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	// only orders not in a terminal phase
+	ActiveOnly bool `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListOrdersRequest) GetSecurities() []*SecurityRef {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// by transaction id
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId int64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// how long to wait for an order not known yet, 10s by default and 1 minute at most
+	Wait *durationpb.Duration `protobuf:"bytes,2,opt,name=wait,proto3" json:"wait,omitempty"`
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_connect_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WatchOrderRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *WatchOrderRequest) GetWait() *durationpb.Duration {
+	if x != nil {
+		return x.Wait
+	}
+	return nil
+}
+
+var File_connect_proto protoreflect.FileDescriptor
+
+var file_connect_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x40, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22,
+	0xed, 0x02, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x45, 0x0a, 0x14, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x53, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x12, 0x73, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x12,
+	0x3e, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b,
+	0x69, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22,
+	0x1d, 0x0a, 0x03, 0x47, 0x61, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0x45,
+	0x0a, 0x0b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e,
+	0x47, 0x61, 0x70, 0x52, 0x03, 0x67, 0x61, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x6e, 0x64, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x22,
+	0x2e, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x9a, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7c, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xb7, 0x04, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x70, 0x6f, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x70, 0x6f, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x69, 0x63, 0x65, 0x78, 0x5f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0e, 0x6d,
+	0x69, 0x63, 0x65, 0x78, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x27, 0x0a, 0x0c, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0c, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x75, 0x74, 0x63,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x07, 0x75,
+	0x74, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x71, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x72, 0x71, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x75,
+	0x73, 0x68, 0x5f, 0x75, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x55, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x73, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x74,
+	0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x50, 0x6f, 0x73,
+	0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x70,
+	0x6f, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x69, 0x63, 0x65, 0x78, 0x5f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x74, 0x63, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x7a, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x7a, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x1d, 0x0a, 0x1b, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x14, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
+	0x6f, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0d, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3d,
+	0x0a, 0x0b, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x67, 0x0a,
+	0x16, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x08,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x66, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x54, 0x0a, 0x13, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xc5, 0x01, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x45, 0x0a, 0x14, 0x73, 0x6c, 0x6f, 0x77,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x53, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x12, 0x73, 0x6c, 0x6f,
+	0x77, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x22, 0x92, 0x09, 0x0a, 0x09, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x04,
+	0x6c, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x61,
+	0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x62, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x09, 0x62, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x03, 0x52, 0x08, 0x62, 0x69, 0x64, 0x44, 0x65, 0x70, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12,
+	0x2b, 0x0a, 0x0f, 0x62, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0d, 0x62, 0x69, 0x64, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08,
+	0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05,
+	0x52, 0x07, 0x6e, 0x75, 0x6d, 0x42, 0x69, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x05, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x0a,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x08, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x09, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x0a, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x68,
+	0x69, 0x67, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x04, 0x68, 0x69, 0x67,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x0c, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x0d, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x0e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x77, 0x61, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x0f, 0x52, 0x07, 0x77, 0x61, 0x70, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x10, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x11, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x54, 0x6f, 0x64, 0x61, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x48, 0x12, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x54, 0x6f, 0x64,
+	0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x48, 0x13, 0x52,
+	0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x62, 0x69, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x69, 0x64, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x69, 0x67, 0x68,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x6f, 0x77, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x77, 0x61, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x76, 0x6f, 0x6c, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa2, 0x03,
+	0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65,
+	0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6e, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x22, 0x0a, 0x07, 0x62, 0x75, 0x79, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x08, 0x2e, 0x42, 0x75, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x07, 0x62, 0x75, 0x79,
+	0x73, 0x65, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x70, 0x65,
+	0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x62, 0x75, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x03, 0x62,
+	0x75, 0x79, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x62, 0x75, 0x79,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65,
+	0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0x2c, 0x0a,
+	0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x05, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb0,
+	0x06, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x65, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x63, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x73, 0x74, 0x65,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x74, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x74, 0x64, 0x69, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6c, 0x6f, 0x74, 0x64, 0x69, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x79,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x6f, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x66, 0x6f, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6f, 0x63, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x69, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x5f, 0x74, 0x7a, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x65, 0x63, 0x54, 0x7a, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x73, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x63, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x18, 0x0a, 0x05, 0x62, 0x67, 0x6f, 0x5f, 0x63, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x04, 0x62, 0x67, 0x6f, 0x43, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x62, 0x67,
+	0x6f, 0x5f, 0x6e, 0x63, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x62, 0x67,
+	0x6f, 0x4e, 0x63, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x62, 0x67, 0x6f, 0x5f, 0x62, 0x75,
+	0x79, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x62, 0x67, 0x6f, 0x42, 0x75,
+	0x79, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x67, 0x6f, 0x5f, 0x63, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62,
+	0x67, 0x6f, 0x5f, 0x6e, 0x63, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x67, 0x6f, 0x5f, 0x62, 0x75,
+	0x79, 0x22, 0x65, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x63, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x63, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x1e, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x2e, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x73, 0x22, 0x5a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x93, 0x01,
+	0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x09, 0x42, 0x6f,
+	0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xed, 0x01, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x55, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x63, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x22, 0x5f, 0x0a,
+	0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x5d,
+	0x0a, 0x08, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb0, 0x03,
+	0x0a, 0x11, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x07, 0x62,
+	0x75, 0x79, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x42,
+	0x75, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x07, 0x62, 0x75, 0x79, 0x73, 0x65, 0x6c, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72,
+	0x72, 0x65, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65,
+	0x72, 0x72, 0x65, 0x66, 0x12, 0x2a, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x6e,
+	0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x08, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x6e, 0x6f, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x22, 0xeb, 0x01, 0x0a, 0x08, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x79,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x72, 0x65, 0x66, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x72, 0x65, 0x66, 0x22, 0x84,
+	0x02, 0x0a, 0x0a, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x72, 0x65, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x72, 0x65, 0x66,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x5f, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x79, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52,
+	0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x07, 0x62, 0x75, 0x79, 0x73, 0x65,
+	0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x42, 0x75, 0x79, 0x53, 0x65,
+	0x6c, 0x6c, 0x52, 0x07, 0x62, 0x75, 0x79, 0x73, 0x65, 0x6c, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4e, 0x6f, 0x12, 0x26, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x26, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x0b, 0x74, 0x61, 0x6b,
+	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x52, 0x0a, 0x74, 0x61, 0x6b,
+	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x22, 0xcf, 0x04, 0x0a, 0x1c, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x07, 0x62, 0x75, 0x79, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x08, 0x2e, 0x42, 0x75, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x07, 0x62, 0x75, 0x79,
+	0x73, 0x65, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x79, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x72, 0x6f, 0x6b, 0x65, 0x72, 0x72, 0x65, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x72, 0x65, 0x66, 0x12, 0x26, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x2a, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0c,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x6f, 0x0a, 0x0e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x65, 0x0a, 0x0d,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x6e, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x6e, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0xba, 0x05, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x6e, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x6e, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x65, 0x63, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x63,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x07, 0x62, 0x75, 0x79, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x08, 0x2e, 0x42, 0x75, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x07, 0x62, 0x75, 0x79,
+	0x73, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x21, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0b, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66,
+	0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x69, 0x0a, 0x11, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x2a, 0xa5, 0x01, 0x0a, 0x12, 0x53, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x0a,
+	0x20, 0x53, 0x4c, 0x4f, 0x57, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x52, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53,
+	0x54, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x4c, 0x4f, 0x57, 0x5f, 0x43, 0x4f, 0x4e, 0x53,
+	0x55, 0x4d, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x4c, 0x4f,
+	0x57, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x4c, 0x4f,
+	0x57, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x98,
+	0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04,
+	0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a,
+	0x45, 0x44, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x2a, 0x91, 0x01, 0x0a, 0x0e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x1c,
+	0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f,
+	0x0a, 0x1b, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x53, 0x10, 0x01, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x48, 0x0a,
+	0x07, 0x42, 0x75, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x55, 0x59, 0x5f,
+	0x53, 0x45, 0x4c, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x55, 0x59, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x5f, 0x42,
+	0x55, 0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x55, 0x59, 0x5f, 0x53, 0x45, 0x4c, 0x4c,
+	0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x55, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x55, 0x4e, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x49,
+	0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x4f, 0x4b, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x46, 0x49, 0x4c,
+	0x4c, 0x45, 0x44, 0x5f, 0x49, 0x4f, 0x43, 0x10, 0x02, 0x2a, 0xc4, 0x01, 0x0a, 0x0c, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x57, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x49,
+	0x54, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x4d, 0x41, 0x52,
+	0x4b, 0x45, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x05,
+	0x2a, 0xf3, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a,
+	0x15, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x44,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43,
+	0x4f, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f,
+	0x4c, 0x41, 0x53, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x4b, 0x5f, 0x4f, 0x52, 0x5f, 0x4c, 0x41,
+	0x53, 0x54, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x44,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x56, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06,
+	0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f,
+	0x56, 0x5f, 0x55, 0x50, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x10, 0x08, 0x12, 0x17, 0x0a,
+	0x13, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x09, 0x2a, 0x6b, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x4b, 0x45, 0x45, 0x50, 0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x53, 0x45, 0x54,
+	0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x4d,
+	0x4f, 0x56, 0x45, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x51, 0x55, 0x41,
+	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x46, 0x5f, 0x55, 0x4e, 0x46, 0x49, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0xd7, 0x01, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x41, 0x4c, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xd7, 0x01,
+	0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x13,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50,
+	0x48, 0x41, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x49, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50,
+	0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41,
+	0x53, 0x45, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x17,
+	0x0a, 0x13, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x07, 0x32, 0x82, 0x0c, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x11, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x0c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x13, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x12, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x13, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x15, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x11, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x13, 0x2e,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x10, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x13, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x09, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x18, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x16,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0a, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x15, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x09, 0x4d,
+	0x6f, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c,
+	0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x00, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_connect_proto_rawDescOnce sync.Once
+	file_connect_proto_rawDescData = file_connect_proto_rawDesc
+)
+
+func file_connect_proto_rawDescGZIP() []byte {
+	file_connect_proto_rawDescOnce.Do(func() {
+		file_connect_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_proto_rawDescData)
+	})
+	return file_connect_proto_rawDescData
+}
+
+var file_connect_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_connect_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_connect_proto_goTypes = []interface{}{
+	(SlowConsumerPolicy)(0),              // 0: SlowConsumerPolicy
+	(ConnectionState)(0),                 // 1: ConnectionState
+	(MarketDataKind)(0),                  // 2: MarketDataKind
+	(BuySell)(0),                         // 3: BuySell
+	(OrderUnfilled)(0),                   // 4: OrderUnfilled
+	(ValidityKind)(0),                    // 5: ValidityKind
+	(CondType)(0),                        // 6: CondType
+	(MoveFlag)(0),                        // 7: MoveFlag
+	(OrderRejectionReason)(0),            // 8: OrderRejectionReason
+	(OrderPhase)(0),                      // 9: OrderPhase
+	(*SecurityFilter)(nil),               // 10: SecurityFilter
+	(*MessageFilter)(nil),                // 11: MessageFilter
+	(*DataRequest)(nil),                  // 12: DataRequest
+	(*Gap)(nil),                          // 13: Gap
+	(*SnapshotEnd)(nil),                  // 14: SnapshotEnd
+	(*DataResponse)(nil),                 // 15: DataResponse
+	(*SendCommandRequest)(nil),           // 16: SendCommandRequest
+	(*SendCommandResponse)(nil),          // 17: SendCommandResponse
+	(*ConnectProxy)(nil),                 // 18: ConnectProxy
+	(*ConnectRequest)(nil),               // 19: ConnectRequest
+	(*ConnectResponse)(nil),              // 20: ConnectResponse
+	(*DisconnectRequest)(nil),            // 21: DisconnectRequest
+	(*DisconnectResponse)(nil),           // 22: DisconnectResponse
+	(*ConnectionStatus)(nil),             // 23: ConnectionStatus
+	(*ServerStatusRequest)(nil),          // 24: ServerStatusRequest
+	(*WatchConnectionStateRequest)(nil),  // 25: WatchConnectionStateRequest
+	(*SetLogLevelRequest)(nil),           // 26: SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),          // 27: SetLogLevelResponse
+	(*ReplayJournalRequest)(nil),         // 28: ReplayJournalRequest
+	(*JournalRecord)(nil),                // 29: JournalRecord
+	(*SecurityRef)(nil),                  // 30: SecurityRef
+	(*MarketDataSubscription)(nil),       // 31: MarketDataSubscription
+	(*SubscribeRequest)(nil),             // 32: SubscribeRequest
+	(*SubscribeResponse)(nil),            // 33: SubscribeResponse
+	(*UnsubscribeRequest)(nil),           // 34: UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),          // 35: UnsubscribeResponse
+	(*MarketDataStreamRequest)(nil),      // 36: MarketDataStreamRequest
+	(*Quotation)(nil),                    // 37: Quotation
+	(*MarketTrade)(nil),                  // 38: MarketTrade
+	(*OrderBookLevel)(nil),               // 39: OrderBookLevel
+	(*OrderBookUpdate)(nil),              // 40: OrderBookUpdate
+	(*Market)(nil),                       // 41: Market
+	(*Board)(nil),                        // 42: Board
+	(*CandleKind)(nil),                   // 43: CandleKind
+	(*Security)(nil),                     // 44: Security
+	(*ListSecuritiesRequest)(nil),        // 45: ListSecuritiesRequest
+	(*ListSecuritiesResponse)(nil),       // 46: ListSecuritiesResponse
+	(*GetSecurityRequest)(nil),           // 47: GetSecurityRequest
+	(*SearchSecuritiesRequest)(nil),      // 48: SearchSecuritiesRequest
+	(*SearchSecuritiesResponse)(nil),     // 49: SearchSecuritiesResponse
+	(*BookLevel)(nil),                    // 50: BookLevel
+	(*OrderBook)(nil),                    // 51: OrderBook
+	(*OrderBookDelta)(nil),               // 52: OrderBookDelta
+	(*GetOrderBookRequest)(nil),          // 53: GetOrderBookRequest
+	(*WatchOrderBookRequest)(nil),        // 54: WatchOrderBookRequest
+	(*OrderBookEvent)(nil),               // 55: OrderBookEvent
+	(*Validity)(nil),                     // 56: Validity
+	(*PlaceOrderRequest)(nil),            // 57: PlaceOrderRequest
+	(*StopLoss)(nil),                     // 58: StopLoss
+	(*TakeProfit)(nil),                   // 59: TakeProfit
+	(*PlaceStopOrderRequest)(nil),        // 60: PlaceStopOrderRequest
+	(*PlaceConditionalOrderRequest)(nil), // 61: PlaceConditionalOrderRequest
+	(*CancelOrderRequest)(nil),           // 62: CancelOrderRequest
+	(*MoveOrderRequest)(nil),             // 63: MoveOrderRequest
+	(*OrderRejection)(nil),               // 64: OrderRejection
+	(*OrderResponse)(nil),                // 65: OrderResponse
+	(*OrderTransition)(nil),              // 66: OrderTransition
+	(*OrderFill)(nil),                    // 67: OrderFill
+	(*Order)(nil),                        // 68: Order
+	(*GetOrderRequest)(nil),              // 69: GetOrderRequest
+	(*ListOrdersRequest)(nil),            // 70: ListOrdersRequest
+	(*ListOrdersResponse)(nil),           // 71: ListOrdersResponse
+	(*WatchOrderRequest)(nil),            // 72: WatchOrderRequest
+	(*durationpb.Duration)(nil),          // 73: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 74: google.protobuf.Timestamp
+}
+var file_connect_proto_depIdxs = []int32{
+	10,  // 0: MessageFilter.securities:type_name -> SecurityFilter
+	0,   // 1: DataRequest.slow_consumer_policy:type_name -> SlowConsumerPolicy
+	73,  // 2: DataRequest.block_timeout:type_name -> google.protobuf.Duration
+	11,  // 3: DataRequest.filter:type_name -> MessageFilter
+	13,  // 4: DataResponse.gap:type_name -> Gap
+	74,  // 5: DataResponse.received_at:type_name -> google.protobuf.Timestamp
+	14,  // 6: DataResponse.snapshot_end:type_name -> SnapshotEnd
+	18,  // 7: ConnectRequest.proxy:type_name -> ConnectProxy
+	1,   // 8: ConnectionStatus.state:type_name -> ConnectionState
+	74,  // 9: ConnectionStatus.time:type_name -> google.protobuf.Timestamp
+	1,   // 10: ConnectionStatus.previous_state:type_name -> ConnectionState
+	74,  // 11: JournalRecord.received_at:type_name -> google.protobuf.Timestamp
+	2,   // 12: MarketDataSubscription.kind:type_name -> MarketDataKind
+	30,  // 13: MarketDataSubscription.security:type_name -> SecurityRef
+	30,  // 14: SubscribeRequest.securities:type_name -> SecurityRef
+	2,   // 15: SubscribeRequest.kinds:type_name -> MarketDataKind
+	31,  // 16: SubscribeResponse.subscriptions:type_name -> MarketDataSubscription
+	30,  // 17: UnsubscribeRequest.securities:type_name -> SecurityRef
+	2,   // 18: UnsubscribeRequest.kinds:type_name -> MarketDataKind
+	31,  // 19: UnsubscribeResponse.subscriptions:type_name -> MarketDataSubscription
+	30,  // 20: MarketDataStreamRequest.securities:type_name -> SecurityRef
+	0,   // 21: MarketDataStreamRequest.slow_consumer_policy:type_name -> SlowConsumerPolicy
+	74,  // 22: Quotation.time:type_name -> google.protobuf.Timestamp
+	74,  // 23: Quotation.received_at:type_name -> google.protobuf.Timestamp
+	74,  // 24: MarketTrade.time:type_name -> google.protobuf.Timestamp
+	3,   // 25: MarketTrade.buysell:type_name -> BuySell
+	74,  // 26: MarketTrade.received_at:type_name -> google.protobuf.Timestamp
+	39,  // 27: OrderBookUpdate.levels:type_name -> OrderBookLevel
+	74,  // 28: OrderBookUpdate.received_at:type_name -> google.protobuf.Timestamp
+	44,  // 29: ListSecuritiesResponse.securities:type_name -> Security
+	41,  // 30: ListSecuritiesResponse.markets:type_name -> Market
+	42,  // 31: ListSecuritiesResponse.boards:type_name -> Board
+	43,  // 32: ListSecuritiesResponse.candle_kinds:type_name -> CandleKind
+	44,  // 33: SearchSecuritiesResponse.securities:type_name -> Security
+	50,  // 34: OrderBook.bids:type_name -> BookLevel
+	50,  // 35: OrderBook.asks:type_name -> BookLevel
+	74,  // 36: OrderBook.updated_at:type_name -> google.protobuf.Timestamp
+	50,  // 37: OrderBookDelta.bids:type_name -> BookLevel
+	50,  // 38: OrderBookDelta.asks:type_name -> BookLevel
+	74,  // 39: OrderBookDelta.updated_at:type_name -> google.protobuf.Timestamp
+	30,  // 40: GetOrderBookRequest.security:type_name -> SecurityRef
+	30,  // 41: WatchOrderBookRequest.securities:type_name -> SecurityRef
+	51,  // 42: OrderBookEvent.snapshot:type_name -> OrderBook
+	52,  // 43: OrderBookEvent.delta:type_name -> OrderBookDelta
+	5,   // 44: Validity.kind:type_name -> ValidityKind
+	74,  // 45: Validity.time:type_name -> google.protobuf.Timestamp
+	30,  // 46: PlaceOrderRequest.security:type_name -> SecurityRef
+	3,   // 47: PlaceOrderRequest.buysell:type_name -> BuySell
+	4,   // 48: PlaceOrderRequest.unfilled:type_name -> OrderUnfilled
+	74,  // 49: PlaceOrderRequest.expdate:type_name -> google.protobuf.Timestamp
+	30,  // 50: PlaceStopOrderRequest.security:type_name -> SecurityRef
+	3,   // 51: PlaceStopOrderRequest.buysell:type_name -> BuySell
+	56,  // 52: PlaceStopOrderRequest.valid_for:type_name -> Validity
+	74,  // 53: PlaceStopOrderRequest.expdate:type_name -> google.protobuf.Timestamp
+	58,  // 54: PlaceStopOrderRequest.stop_loss:type_name -> StopLoss
+	59,  // 55: PlaceStopOrderRequest.take_profit:type_name -> TakeProfit
+	30,  // 56: PlaceConditionalOrderRequest.security:type_name -> SecurityRef
+	3,   // 57: PlaceConditionalOrderRequest.buysell:type_name -> BuySell
+	6,   // 58: PlaceConditionalOrderRequest.cond_type:type_name -> CondType
+	56,  // 59: PlaceConditionalOrderRequest.valid_after:type_name -> Validity
+	56,  // 60: PlaceConditionalOrderRequest.valid_before:type_name -> Validity
+	74,  // 61: PlaceConditionalOrderRequest.expdate:type_name -> google.protobuf.Timestamp
+	7,   // 62: MoveOrderRequest.move_flag:type_name -> MoveFlag
+	8,   // 63: OrderRejection.reason:type_name -> OrderRejectionReason
+	64,  // 64: OrderResponse.rejection:type_name -> OrderRejection
+	9,   // 65: OrderTransition.phase:type_name -> OrderPhase
+	74,  // 66: OrderTransition.time:type_name -> google.protobuf.Timestamp
+	74,  // 67: OrderFill.time:type_name -> google.protobuf.Timestamp
+	3,   // 68: Order.buysell:type_name -> BuySell
+	9,   // 69: Order.phase:type_name -> OrderPhase
+	66,  // 70: Order.transitions:type_name -> OrderTransition
+	67,  // 71: Order.fills:type_name -> OrderFill
+	74,  // 72: Order.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 73: Order.updated_at:type_name -> google.protobuf.Timestamp
+	30,  // 74: ListOrdersRequest.securities:type_name -> SecurityRef
+	68,  // 75: ListOrdersResponse.orders:type_name -> Order
+	73,  // 76: WatchOrderRequest.wait:type_name -> google.protobuf.Duration
+	12,  // 77: ConnectService.FetchResponseData:input_type -> DataRequest
+	16,  // 78: ConnectService.SendCommand:input_type -> SendCommandRequest
+	19,  // 79: ConnectService.Connect:input_type -> ConnectRequest
+	21,  // 80: ConnectService.Disconnect:input_type -> DisconnectRequest
+	24,  // 81: ConnectService.GetServerStatus:input_type -> ServerStatusRequest
+	25,  // 82: ConnectService.WatchConnectionState:input_type -> WatchConnectionStateRequest
+	26,  // 83: ConnectService.SetLogLevel:input_type -> SetLogLevelRequest
+	28,  // 84: ConnectService.ReplayJournal:input_type -> ReplayJournalRequest
+	32,  // 85: ConnectService.Subscribe:input_type -> SubscribeRequest
+	34,  // 86: ConnectService.Unsubscribe:input_type -> UnsubscribeRequest
+	36,  // 87: ConnectService.StreamQuotations:input_type -> MarketDataStreamRequest
+	36,  // 88: ConnectService.StreamTrades:input_type -> MarketDataStreamRequest
+	36,  // 89: ConnectService.StreamOrderBook:input_type -> MarketDataStreamRequest
+	45,  // 90: ConnectService.ListSecurities:input_type -> ListSecuritiesRequest
+	47,  // 91: ConnectService.GetSecurity:input_type -> GetSecurityRequest
+	48,  // 92: ConnectService.SearchSecurities:input_type -> SearchSecuritiesRequest
+	53,  // 93: ConnectService.GetOrderBook:input_type -> GetOrderBookRequest
+	54,  // 94: ConnectService.WatchOrderBook:input_type -> WatchOrderBookRequest
+	57,  // 95: ConnectService.PlaceOrder:input_type -> PlaceOrderRequest
+	60,  // 96: ConnectService.PlaceStopOrder:input_type -> PlaceStopOrderRequest
+	61,  // 97: ConnectService.PlaceConditionalOrder:input_type -> PlaceConditionalOrderRequest
+	62,  // 98: ConnectService.CancelOrder:input_type -> CancelOrderRequest
+	63,  // 99: ConnectService.MoveOrder:input_type -> MoveOrderRequest
+	69,  // 100: ConnectService.GetOrder:input_type -> GetOrderRequest
+	70,  // 101: ConnectService.ListOrders:input_type -> ListOrdersRequest
+	72,  // 102: ConnectService.WatchOrder:input_type -> WatchOrderRequest
+	15,  // 103: ConnectService.FetchResponseData:output_type -> DataResponse
+	17,  // 104: ConnectService.SendCommand:output_type -> SendCommandResponse
+	20,  // 105: ConnectService.Connect:output_type -> ConnectResponse
+	22,  // 106: ConnectService.Disconnect:output_type -> DisconnectResponse
+	23,  // 107: ConnectService.GetServerStatus:output_type -> ConnectionStatus
+	23,  // 108: ConnectService.WatchConnectionState:output_type -> ConnectionStatus
+	27,  // 109: ConnectService.SetLogLevel:output_type -> SetLogLevelResponse
+	29,  // 110: ConnectService.ReplayJournal:output_type -> JournalRecord
+	33,  // 111: ConnectService.Subscribe:output_type -> SubscribeResponse
+	35,  // 112: ConnectService.Unsubscribe:output_type -> UnsubscribeResponse
+	37,  // 113: ConnectService.StreamQuotations:output_type -> Quotation
+	38,  // 114: ConnectService.StreamTrades:output_type -> MarketTrade
+	40,  // 115: ConnectService.StreamOrderBook:output_type -> OrderBookUpdate
+	46,  // 116: ConnectService.ListSecurities:output_type -> ListSecuritiesResponse
+	44,  // 117: ConnectService.GetSecurity:output_type -> Security
+	49,  // 118: ConnectService.SearchSecurities:output_type -> SearchSecuritiesResponse
+	51,  // 119: ConnectService.GetOrderBook:output_type -> OrderBook
+	55,  // 120: ConnectService.WatchOrderBook:output_type -> OrderBookEvent
+	65,  // 121: ConnectService.PlaceOrder:output_type -> OrderResponse
+	65,  // 122: ConnectService.PlaceStopOrder:output_type -> OrderResponse
+	65,  // 123: ConnectService.PlaceConditionalOrder:output_type -> OrderResponse
+	65,  // 124: ConnectService.CancelOrder:output_type -> OrderResponse
+	65,  // 125: ConnectService.MoveOrder:output_type -> OrderResponse
+	68,  // 126: ConnectService.GetOrder:output_type -> Order
+	71,  // 127: ConnectService.ListOrders:output_type -> ListOrdersResponse
+	68,  // 128: ConnectService.WatchOrder:output_type -> Order
+	103, // [103:129] is the sub-list for method output_type
+	77,  // [77:103] is the sub-list for method input_type
+	77,  // [77:77] is the sub-list for extension type_name
+	77,  // [77:77] is the sub-list for extension extendee
+	0,   // [0:77] is the sub-list for field type_name
+}
+
+func init() { file_connect_proto_init() }
+func file_connect_proto_init() {
+	if File_connect_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_connect_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_connect_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataResponse); i {
 			case 0:
 				return &v.state
@@ -294,8 +6948,368 @@ func file_connect_proto_init() {
 				return nil
 			}
 		}
-		file_connect_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendCommandRequest); i {
+		file_connect_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchConnectionStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayJournalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDataSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketDataStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketTrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Market); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Board); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandleKind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Security); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSecuritiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSecuritiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -306,8 +7320,308 @@ func file_connect_proto_init() {
 				return nil
 			}
 		}
-		file_connect_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendCommandResponse); i {
+		file_connect_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecurityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSecuritiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSecuritiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOrderBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Validity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopLoss); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TakeProfit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceStopOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceConditionalOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderRejection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderFill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -319,18 +7633,24 @@ func file_connect_proto_init() {
 			}
 		}
 	}
+	file_connect_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_connect_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_connect_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_connect_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_connect_proto_msgTypes[40].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connect_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      10,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_connect_proto_goTypes,
 		DependencyIndexes: file_connect_proto_depIdxs,
+		EnumInfos:         file_connect_proto_enumTypes,
 		MessageInfos:      file_connect_proto_msgTypes,
 	}.Build()
 	File_connect_proto = out.File
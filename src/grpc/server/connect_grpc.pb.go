@@ -19,8 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ConnectService_FetchResponseData_FullMethodName = "/ConnectService/FetchResponseData"
-	ConnectService_SendCommand_FullMethodName       = "/ConnectService/SendCommand"
+	ConnectService_FetchResponseData_FullMethodName     = "/ConnectService/FetchResponseData"
+	ConnectService_SendCommand_FullMethodName           = "/ConnectService/SendCommand"
+	ConnectService_Connect_FullMethodName               = "/ConnectService/Connect"
+	ConnectService_Disconnect_FullMethodName            = "/ConnectService/Disconnect"
+	ConnectService_GetServerStatus_FullMethodName       = "/ConnectService/GetServerStatus"
+	ConnectService_WatchConnectionState_FullMethodName  = "/ConnectService/WatchConnectionState"
+	ConnectService_SetLogLevel_FullMethodName           = "/ConnectService/SetLogLevel"
+	ConnectService_ReplayJournal_FullMethodName         = "/ConnectService/ReplayJournal"
+	ConnectService_Subscribe_FullMethodName             = "/ConnectService/Subscribe"
+	ConnectService_Unsubscribe_FullMethodName           = "/ConnectService/Unsubscribe"
+	ConnectService_StreamQuotations_FullMethodName      = "/ConnectService/StreamQuotations"
+	ConnectService_StreamTrades_FullMethodName          = "/ConnectService/StreamTrades"
+	ConnectService_StreamOrderBook_FullMethodName       = "/ConnectService/StreamOrderBook"
+	ConnectService_ListSecurities_FullMethodName        = "/ConnectService/ListSecurities"
+	ConnectService_GetSecurity_FullMethodName           = "/ConnectService/GetSecurity"
+	ConnectService_SearchSecurities_FullMethodName      = "/ConnectService/SearchSecurities"
+	ConnectService_GetOrderBook_FullMethodName          = "/ConnectService/GetOrderBook"
+	ConnectService_WatchOrderBook_FullMethodName        = "/ConnectService/WatchOrderBook"
+	ConnectService_PlaceOrder_FullMethodName            = "/ConnectService/PlaceOrder"
+	ConnectService_PlaceStopOrder_FullMethodName        = "/ConnectService/PlaceStopOrder"
+	ConnectService_PlaceConditionalOrder_FullMethodName = "/ConnectService/PlaceConditionalOrder"
+	ConnectService_CancelOrder_FullMethodName           = "/ConnectService/CancelOrder"
+	ConnectService_MoveOrder_FullMethodName             = "/ConnectService/MoveOrder"
+	ConnectService_GetOrder_FullMethodName              = "/ConnectService/GetOrder"
+	ConnectService_ListOrders_FullMethodName            = "/ConnectService/ListOrders"
+	ConnectService_WatchOrder_FullMethodName            = "/ConnectService/WatchOrder"
 )
 
 // ConnectServiceClient is the client API for ConnectService service.
@@ -29,6 +53,39 @@ const (
 type ConnectServiceClient interface {
 	FetchResponseData(ctx context.Context, in *DataRequest, opts ...grpc.CallOption) (ConnectService_FetchResponseDataClient, error)
 	SendCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*SendCommandResponse, error)
+	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectResponse, error)
+	Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error)
+	GetServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (*ConnectionStatus, error)
+	WatchConnectionState(ctx context.Context, in *WatchConnectionStateRequest, opts ...grpc.CallOption) (ConnectService_WatchConnectionStateClient, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// OUT_OF_RANGE when from_sequence is removed by retention or not written yet
+	ReplayJournal(ctx context.Context, in *ReplayJournalRequest, opts ...grpc.CallOption) (ConnectService_ReplayJournalClient, error)
+	// subscribe and unsubscribe are sent to Transaq only when the first subscriber
+	// comes or the last one leaves
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+	StreamQuotations(ctx context.Context, in *MarketDataStreamRequest, opts ...grpc.CallOption) (ConnectService_StreamQuotationsClient, error)
+	StreamTrades(ctx context.Context, in *MarketDataStreamRequest, opts ...grpc.CallOption) (ConnectService_StreamTradesClient, error)
+	StreamOrderBook(ctx context.Context, in *MarketDataStreamRequest, opts ...grpc.CallOption) (ConnectService_StreamOrderBookClient, error)
+	ListSecurities(ctx context.Context, in *ListSecuritiesRequest, opts ...grpc.CallOption) (*ListSecuritiesResponse, error)
+	GetSecurity(ctx context.Context, in *GetSecurityRequest, opts ...grpc.CallOption) (*Security, error)
+	SearchSecurities(ctx context.Context, in *SearchSecuritiesRequest, opts ...grpc.CallOption) (*SearchSecuritiesResponse, error)
+	// NOT_FOUND when no quotes were received for the security
+	GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*OrderBook, error)
+	WatchOrderBook(ctx context.Context, in *WatchOrderBookRequest, opts ...grpc.CallOption) (ConnectService_WatchOrderBookClient, error)
+	// rejections are INVALID_ARGUMENT or FAILED_PRECONDITION with OrderResponse in the details
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	PlaceStopOrder(ctx context.Context, in *PlaceStopOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	PlaceConditionalOrder(ctx context.Context, in *PlaceConditionalOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	// NOT_FOUND when no callback was received for the order
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	// sends the order on every change and completes after a terminal one, a
+	// matched order once its trades cover the quantity. An order not known yet is
+	// waited for, NOT_FOUND if it does not show up
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (ConnectService_WatchOrderClient, error)
 }
 
 type connectServiceClient struct {
@@ -80,77 +137,1012 @@ func (c *connectServiceClient) SendCommand(ctx context.Context, in *SendCommandR
 	return out, nil
 }
 
+func (c *connectServiceClient) Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectResponse, error) {
+	out := new(ConnectResponse)
+	err := c.cc.Invoke(ctx, ConnectService_Connect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error) {
+	out := new(DisconnectResponse)
+	err := c.cc.Invoke(ctx, ConnectService_Disconnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) GetServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (*ConnectionStatus, error) {
+	out := new(ConnectionStatus)
+	err := c.cc.Invoke(ctx, ConnectService_GetServerStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) WatchConnectionState(ctx context.Context, in *WatchConnectionStateRequest, opts ...grpc.CallOption) (ConnectService_WatchConnectionStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConnectService_ServiceDesc.Streams[1], ConnectService_WatchConnectionState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectServiceWatchConnectionStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConnectService_WatchConnectionStateClient interface {
+	Recv() (*ConnectionStatus, error)
+	grpc.ClientStream
+}
+
+type connectServiceWatchConnectionStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectServiceWatchConnectionStateClient) Recv() (*ConnectionStatus, error) {
+	m := new(ConnectionStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *connectServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, ConnectService_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) ReplayJournal(ctx context.Context, in *ReplayJournalRequest, opts ...grpc.CallOption) (ConnectService_ReplayJournalClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConnectService_ServiceDesc.Streams[2], ConnectService_ReplayJournal_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectServiceReplayJournalClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConnectService_ReplayJournalClient interface {
+	Recv() (*JournalRecord, error)
+	grpc.ClientStream
+}
+
+type connectServiceReplayJournalClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectServiceReplayJournalClient) Recv() (*JournalRecord, error) {
+	m := new(JournalRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *connectServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, ConnectService_Subscribe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, ConnectService_Unsubscribe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) StreamQuotations(ctx context.Context, in *MarketDataStreamRequest, opts ...grpc.CallOption) (ConnectService_StreamQuotationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConnectService_ServiceDesc.Streams[3], ConnectService_StreamQuotations_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectServiceStreamQuotationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConnectService_StreamQuotationsClient interface {
+	Recv() (*Quotation, error)
+	grpc.ClientStream
+}
+
+type connectServiceStreamQuotationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectServiceStreamQuotationsClient) Recv() (*Quotation, error) {
+	m := new(Quotation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *connectServiceClient) StreamTrades(ctx context.Context, in *MarketDataStreamRequest, opts ...grpc.CallOption) (ConnectService_StreamTradesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConnectService_ServiceDesc.Streams[4], ConnectService_StreamTrades_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectServiceStreamTradesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConnectService_StreamTradesClient interface {
+	Recv() (*MarketTrade, error)
+	grpc.ClientStream
+}
+
+type connectServiceStreamTradesClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectServiceStreamTradesClient) Recv() (*MarketTrade, error) {
+	m := new(MarketTrade)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *connectServiceClient) StreamOrderBook(ctx context.Context, in *MarketDataStreamRequest, opts ...grpc.CallOption) (ConnectService_StreamOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConnectService_ServiceDesc.Streams[5], ConnectService_StreamOrderBook_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectServiceStreamOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConnectService_StreamOrderBookClient interface {
+	Recv() (*OrderBookUpdate, error)
+	grpc.ClientStream
+}
+
+type connectServiceStreamOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectServiceStreamOrderBookClient) Recv() (*OrderBookUpdate, error) {
+	m := new(OrderBookUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *connectServiceClient) ListSecurities(ctx context.Context, in *ListSecuritiesRequest, opts ...grpc.CallOption) (*ListSecuritiesResponse, error) {
+	out := new(ListSecuritiesResponse)
+	err := c.cc.Invoke(ctx, ConnectService_ListSecurities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) GetSecurity(ctx context.Context, in *GetSecurityRequest, opts ...grpc.CallOption) (*Security, error) {
+	out := new(Security)
+	err := c.cc.Invoke(ctx, ConnectService_GetSecurity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) SearchSecurities(ctx context.Context, in *SearchSecuritiesRequest, opts ...grpc.CallOption) (*SearchSecuritiesResponse, error) {
+	out := new(SearchSecuritiesResponse)
+	err := c.cc.Invoke(ctx, ConnectService_SearchSecurities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*OrderBook, error) {
+	out := new(OrderBook)
+	err := c.cc.Invoke(ctx, ConnectService_GetOrderBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) WatchOrderBook(ctx context.Context, in *WatchOrderBookRequest, opts ...grpc.CallOption) (ConnectService_WatchOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConnectService_ServiceDesc.Streams[6], ConnectService_WatchOrderBook_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectServiceWatchOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConnectService_WatchOrderBookClient interface {
+	Recv() (*OrderBookEvent, error)
+	grpc.ClientStream
+}
+
+type connectServiceWatchOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectServiceWatchOrderBookClient) Recv() (*OrderBookEvent, error) {
+	m := new(OrderBookEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *connectServiceClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, ConnectService_PlaceOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) PlaceStopOrder(ctx context.Context, in *PlaceStopOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, ConnectService_PlaceStopOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) PlaceConditionalOrder(ctx context.Context, in *PlaceConditionalOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, ConnectService_PlaceConditionalOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, ConnectService_CancelOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, ConnectService_MoveOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, ConnectService_GetOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, ConnectService_ListOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectServiceClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (ConnectService_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConnectService_ServiceDesc.Streams[7], ConnectService_WatchOrder_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &connectServiceWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConnectService_WatchOrderClient interface {
+	Recv() (*Order, error)
+	grpc.ClientStream
+}
+
+type connectServiceWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *connectServiceWatchOrderClient) Recv() (*Order, error) {
+	m := new(Order)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ConnectServiceServer is the server API for ConnectService service.
 // All implementations must embed UnimplementedConnectServiceServer
 // for forward compatibility
 type ConnectServiceServer interface {
 	FetchResponseData(*DataRequest, ConnectService_FetchResponseDataServer) error
 	SendCommand(context.Context, *SendCommandRequest) (*SendCommandResponse, error)
+	Connect(context.Context, *ConnectRequest) (*ConnectResponse, error)
+	Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error)
+	GetServerStatus(context.Context, *ServerStatusRequest) (*ConnectionStatus, error)
+	WatchConnectionState(*WatchConnectionStateRequest, ConnectService_WatchConnectionStateServer) error
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// OUT_OF_RANGE when from_sequence is removed by retention or not written yet
+	ReplayJournal(*ReplayJournalRequest, ConnectService_ReplayJournalServer) error
+	// subscribe and unsubscribe are sent to Transaq only when the first subscriber
+	// comes or the last one leaves
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	StreamQuotations(*MarketDataStreamRequest, ConnectService_StreamQuotationsServer) error
+	StreamTrades(*MarketDataStreamRequest, ConnectService_StreamTradesServer) error
+	StreamOrderBook(*MarketDataStreamRequest, ConnectService_StreamOrderBookServer) error
+	ListSecurities(context.Context, *ListSecuritiesRequest) (*ListSecuritiesResponse, error)
+	GetSecurity(context.Context, *GetSecurityRequest) (*Security, error)
+	SearchSecurities(context.Context, *SearchSecuritiesRequest) (*SearchSecuritiesResponse, error)
+	// NOT_FOUND when no quotes were received for the security
+	GetOrderBook(context.Context, *GetOrderBookRequest) (*OrderBook, error)
+	WatchOrderBook(*WatchOrderBookRequest, ConnectService_WatchOrderBookServer) error
+	// rejections are INVALID_ARGUMENT or FAILED_PRECONDITION with OrderResponse in the details
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*OrderResponse, error)
+	PlaceStopOrder(context.Context, *PlaceStopOrderRequest) (*OrderResponse, error)
+	PlaceConditionalOrder(context.Context, *PlaceConditionalOrderRequest) (*OrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*OrderResponse, error)
+	MoveOrder(context.Context, *MoveOrderRequest) (*OrderResponse, error)
+	// NOT_FOUND when no callback was received for the order
+	GetOrder(context.Context, *GetOrderRequest) (*Order, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	// sends the order on every change and completes after a terminal one, a
+	// matched order once its trades cover the quantity. An order not known yet is
+	// waited for, NOT_FOUND if it does not show up
+	WatchOrder(*WatchOrderRequest, ConnectService_WatchOrderServer) error
+	mustEmbedUnimplementedConnectServiceServer()
+}
+
+// UnimplementedConnectServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedConnectServiceServer struct {
+}
+
+func (UnimplementedConnectServiceServer) FetchResponseData(*DataRequest, ConnectService_FetchResponseDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method FetchResponseData not implemented")
+}
+func (UnimplementedConnectServiceServer) SendCommand(context.Context, *SendCommandRequest) (*SendCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommand not implemented")
+}
+func (UnimplementedConnectServiceServer) Connect(context.Context, *ConnectRequest) (*ConnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedConnectServiceServer) Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedConnectServiceServer) GetServerStatus(context.Context, *ServerStatusRequest) (*ConnectionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerStatus not implemented")
+}
+func (UnimplementedConnectServiceServer) WatchConnectionState(*WatchConnectionStateRequest, ConnectService_WatchConnectionStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConnectionState not implemented")
+}
+func (UnimplementedConnectServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedConnectServiceServer) ReplayJournal(*ReplayJournalRequest, ConnectService_ReplayJournalServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayJournal not implemented")
+}
+func (UnimplementedConnectServiceServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedConnectServiceServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedConnectServiceServer) StreamQuotations(*MarketDataStreamRequest, ConnectService_StreamQuotationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQuotations not implemented")
+}
+func (UnimplementedConnectServiceServer) StreamTrades(*MarketDataStreamRequest, ConnectService_StreamTradesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTrades not implemented")
+}
+func (UnimplementedConnectServiceServer) StreamOrderBook(*MarketDataStreamRequest, ConnectService_StreamOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderBook not implemented")
+}
+func (UnimplementedConnectServiceServer) ListSecurities(context.Context, *ListSecuritiesRequest) (*ListSecuritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurities not implemented")
+}
+func (UnimplementedConnectServiceServer) GetSecurity(context.Context, *GetSecurityRequest) (*Security, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecurity not implemented")
+}
+func (UnimplementedConnectServiceServer) SearchSecurities(context.Context, *SearchSecuritiesRequest) (*SearchSecuritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSecurities not implemented")
+}
+func (UnimplementedConnectServiceServer) GetOrderBook(context.Context, *GetOrderBookRequest) (*OrderBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
+}
+func (UnimplementedConnectServiceServer) WatchOrderBook(*WatchOrderBookRequest, ConnectService_WatchOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrderBook not implemented")
+}
+func (UnimplementedConnectServiceServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedConnectServiceServer) PlaceStopOrder(context.Context, *PlaceStopOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceStopOrder not implemented")
+}
+func (UnimplementedConnectServiceServer) PlaceConditionalOrder(context.Context, *PlaceConditionalOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceConditionalOrder not implemented")
+}
+func (UnimplementedConnectServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedConnectServiceServer) MoveOrder(context.Context, *MoveOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveOrder not implemented")
+}
+func (UnimplementedConnectServiceServer) GetOrder(context.Context, *GetOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedConnectServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedConnectServiceServer) WatchOrder(*WatchOrderRequest, ConnectService_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedConnectServiceServer) mustEmbedUnimplementedConnectServiceServer() {}
+
+// UnsafeConnectServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConnectServiceServer will
+// result in compilation errors.
+type UnsafeConnectServiceServer interface {
 	mustEmbedUnimplementedConnectServiceServer()
 }
 
-// UnimplementedConnectServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedConnectServiceServer struct {
+func RegisterConnectServiceServer(s grpc.ServiceRegistrar, srv ConnectServiceServer) {
+	s.RegisterService(&ConnectService_ServiceDesc, srv)
+}
+
+func _ConnectService_FetchResponseData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectServiceServer).FetchResponseData(m, &connectServiceFetchResponseDataServer{stream})
+}
+
+type ConnectService_FetchResponseDataServer interface {
+	Send(*DataResponse) error
+	grpc.ServerStream
+}
+
+type connectServiceFetchResponseDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *connectServiceFetchResponseDataServer) Send(m *DataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConnectService_SendCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).SendCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_SendCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).SendCommand(ctx, req.(*SendCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).Connect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_Connect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).Connect(ctx, req.(*ConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_Disconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).Disconnect(ctx, req.(*DisconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_GetServerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).GetServerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_GetServerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).GetServerStatus(ctx, req.(*ServerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_WatchConnectionState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConnectionStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectServiceServer).WatchConnectionState(m, &connectServiceWatchConnectionStateServer{stream})
+}
+
+type ConnectService_WatchConnectionStateServer interface {
+	Send(*ConnectionStatus) error
+	grpc.ServerStream
+}
+
+type connectServiceWatchConnectionStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *connectServiceWatchConnectionStateServer) Send(m *ConnectionStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConnectService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_ReplayJournal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayJournalRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectServiceServer).ReplayJournal(m, &connectServiceReplayJournalServer{stream})
+}
+
+type ConnectService_ReplayJournalServer interface {
+	Send(*JournalRecord) error
+	grpc.ServerStream
+}
+
+type connectServiceReplayJournalServer struct {
+	grpc.ServerStream
+}
+
+func (x *connectServiceReplayJournalServer) Send(m *JournalRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConnectService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_StreamQuotations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MarketDataStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectServiceServer).StreamQuotations(m, &connectServiceStreamQuotationsServer{stream})
+}
+
+type ConnectService_StreamQuotationsServer interface {
+	Send(*Quotation) error
+	grpc.ServerStream
 }
 
-func (UnimplementedConnectServiceServer) FetchResponseData(*DataRequest, ConnectService_FetchResponseDataServer) error {
-	return status.Errorf(codes.Unimplemented, "method FetchResponseData not implemented")
+type connectServiceStreamQuotationsServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedConnectServiceServer) SendCommand(context.Context, *SendCommandRequest) (*SendCommandResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendCommand not implemented")
+
+func (x *connectServiceStreamQuotationsServer) Send(m *Quotation) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedConnectServiceServer) mustEmbedUnimplementedConnectServiceServer() {}
 
-// UnsafeConnectServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ConnectServiceServer will
-// result in compilation errors.
-type UnsafeConnectServiceServer interface {
-	mustEmbedUnimplementedConnectServiceServer()
+func _ConnectService_StreamTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MarketDataStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectServiceServer).StreamTrades(m, &connectServiceStreamTradesServer{stream})
 }
 
-func RegisterConnectServiceServer(s grpc.ServiceRegistrar, srv ConnectServiceServer) {
-	s.RegisterService(&ConnectService_ServiceDesc, srv)
+type ConnectService_StreamTradesServer interface {
+	Send(*MarketTrade) error
+	grpc.ServerStream
 }
 
-func _ConnectService_FetchResponseData_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DataRequest)
+type connectServiceStreamTradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *connectServiceStreamTradesServer) Send(m *MarketTrade) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConnectService_StreamOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MarketDataStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ConnectServiceServer).FetchResponseData(m, &connectServiceFetchResponseDataServer{stream})
+	return srv.(ConnectServiceServer).StreamOrderBook(m, &connectServiceStreamOrderBookServer{stream})
 }
 
-type ConnectService_FetchResponseDataServer interface {
-	Send(*DataResponse) error
+type ConnectService_StreamOrderBookServer interface {
+	Send(*OrderBookUpdate) error
 	grpc.ServerStream
 }
 
-type connectServiceFetchResponseDataServer struct {
+type connectServiceStreamOrderBookServer struct {
 	grpc.ServerStream
 }
 
-func (x *connectServiceFetchResponseDataServer) Send(m *DataResponse) error {
+func (x *connectServiceStreamOrderBookServer) Send(m *OrderBookUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _ConnectService_SendCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendCommandRequest)
+func _ConnectService_ListSecurities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecuritiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConnectServiceServer).SendCommand(ctx, in)
+		return srv.(ConnectServiceServer).ListSecurities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConnectService_SendCommand_FullMethodName,
+		FullMethod: ConnectService_ListSecurities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConnectServiceServer).SendCommand(ctx, req.(*SendCommandRequest))
+		return srv.(ConnectServiceServer).ListSecurities(ctx, req.(*ListSecuritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_GetSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecurityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).GetSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_GetSecurity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).GetSecurity(ctx, req.(*GetSecurityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_SearchSecurities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSecuritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).SearchSecurities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_SearchSecurities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).SearchSecurities(ctx, req.(*SearchSecuritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_GetOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).GetOrderBook(ctx, req.(*GetOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_WatchOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectServiceServer).WatchOrderBook(m, &connectServiceWatchOrderBookServer{stream})
+}
+
+type ConnectService_WatchOrderBookServer interface {
+	Send(*OrderBookEvent) error
+	grpc.ServerStream
+}
+
+type connectServiceWatchOrderBookServer struct {
+	grpc.ServerStream
+}
+
+func (x *connectServiceWatchOrderBookServer) Send(m *OrderBookEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConnectService_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_PlaceStopOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceStopOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).PlaceStopOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_PlaceStopOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).PlaceStopOrder(ctx, req.(*PlaceStopOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_PlaceConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).PlaceConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_PlaceConditionalOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).PlaceConditionalOrder(ctx, req.(*PlaceConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_MoveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).MoveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_MoveOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).MoveOrder(ctx, req.(*MoveOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConnectService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConnectServiceServer).WatchOrder(m, &connectServiceWatchOrderServer{stream})
+}
+
+type ConnectService_WatchOrderServer interface {
+	Send(*Order) error
+	grpc.ServerStream
+}
+
+type connectServiceWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *connectServiceWatchOrderServer) Send(m *Order) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ConnectService_ServiceDesc is the grpc.ServiceDesc for ConnectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -162,6 +1154,74 @@ var ConnectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendCommand",
 			Handler:    _ConnectService_SendCommand_Handler,
 		},
+		{
+			MethodName: "Connect",
+			Handler:    _ConnectService_Connect_Handler,
+		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _ConnectService_Disconnect_Handler,
+		},
+		{
+			MethodName: "GetServerStatus",
+			Handler:    _ConnectService_GetServerStatus_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _ConnectService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _ConnectService_Subscribe_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _ConnectService_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ListSecurities",
+			Handler:    _ConnectService_ListSecurities_Handler,
+		},
+		{
+			MethodName: "GetSecurity",
+			Handler:    _ConnectService_GetSecurity_Handler,
+		},
+		{
+			MethodName: "SearchSecurities",
+			Handler:    _ConnectService_SearchSecurities_Handler,
+		},
+		{
+			MethodName: "GetOrderBook",
+			Handler:    _ConnectService_GetOrderBook_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _ConnectService_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "PlaceStopOrder",
+			Handler:    _ConnectService_PlaceStopOrder_Handler,
+		},
+		{
+			MethodName: "PlaceConditionalOrder",
+			Handler:    _ConnectService_PlaceConditionalOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _ConnectService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "MoveOrder",
+			Handler:    _ConnectService_MoveOrder_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _ConnectService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _ConnectService_ListOrders_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -169,6 +1229,41 @@ var ConnectService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ConnectService_FetchResponseData_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchConnectionState",
+			Handler:       _ConnectService_WatchConnectionState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplayJournal",
+			Handler:       _ConnectService_ReplayJournal_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamQuotations",
+			Handler:       _ConnectService_StreamQuotations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTrades",
+			Handler:       _ConnectService_StreamTrades_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamOrderBook",
+			Handler:       _ConnectService_StreamOrderBook_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOrderBook",
+			Handler:       _ConnectService_WatchOrderBook_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _ConnectService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "connect.proto",
 }
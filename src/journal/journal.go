@@ -0,0 +1,384 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rs/zerolog"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+const retentionInterval = time.Minute
+
+var ErrOutOfRange = errors.New("sequence is out of the journal range")
+
+type Options struct {
+	Directory string
+	// the active segment is rotated once it grows over SegmentSize bytes
+	SegmentSize int64
+	// 0 disables the limit, the active segment is never removed
+	MaxSize int64
+	MaxAge  time.Duration
+	// 0 syncs every record to the disk, otherwise records are synced by Run.
+	// Append runs on the connector callback thread, with 0 every message waits
+	// for an fsync before it is queued for the clients, a few milliseconds on
+	// most disks.
+	SyncInterval time.Duration
+}
+
+// Journal is an append-only log of callback messages split into segments and
+// ordered by the sequence numbers given by the caller, a sequence that failed to
+// be written leaves a hole. Records reach the file on Append, so nothing is lost
+// when the process dies, a power loss can take the records not synced yet.
+type Journal struct {
+	mutex    *sync.Mutex
+	options  Options
+	segments []*segment
+	active   *os.File
+	// the last appended sequence, numbering continues from it after a restart
+	last   uint64
+	dirty  bool
+	buffer []byte
+
+	localLogger *zerolog.Logger
+}
+
+func NewJournal(logger *zerolog.Logger, options Options) (*Journal, error) {
+	localLogger := logger.With().Str("Service", "Journal").Logger()
+
+	if options.Directory == "" {
+		return nil, errors.New("journal directory is empty")
+	}
+	if options.SegmentSize <= 0 {
+		return nil, errors.New("journal segment size must be positive")
+	}
+
+	err := os.MkdirAll(options.Directory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	segments, err := listSegments(options.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &Journal{
+		mutex:       &sync.Mutex{},
+		options:     options,
+		segments:    segments,
+		localLogger: &localLogger,
+	}
+
+	if len(segments) == 0 {
+		err = j.openSegment(1)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = j.openLast()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	j.removeExpired()
+	if options.SyncInterval == 0 {
+		j.localLogger.Warn().Msg("Journal syncs every message, the callbacks wait for the disk")
+	}
+	if j.first() > j.last {
+		j.localLogger.Info().Msgf("Journal %s is empty, next sequence %d", options.Directory, j.last+1)
+	} else {
+		j.localLogger.Info().Msgf("Journal %s holds sequences %d-%d", options.Directory, j.first(), j.last)
+	}
+
+	return j, nil
+}
+
+func (j *Journal) openLast() error {
+	last := j.segments[len(j.segments)-1]
+	size := last.size
+
+	sequence, err := recoverSegment(last)
+	if err != nil {
+		return fmt.Errorf("journal recovery of %s: %w", last.path, err)
+	}
+	if last.size != size {
+		j.localLogger.Warn().Msgf("Journal segment %s cut to %d bytes after an incomplete record", last.path, last.size)
+	}
+
+	j.active, err = os.OpenFile(last.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	j.last = sequence
+
+	return nil
+}
+
+// openSegment starts a new active segment with the first record to append,
+// must be called with the mutex held.
+func (j *Journal) openSegment(first uint64) error {
+	path := segmentPath(j.options.Directory, first)
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	j.active = file
+	j.segments = append(j.segments, &segment{first: first, path: path})
+	j.last = first - 1
+
+	return nil
+}
+
+// Append writes the record, its sequence must be greater than the last one.
+func (j *Journal) Append(record Record) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.active == nil {
+		return os.ErrClosed
+	}
+	if record.Sequence <= j.last {
+		return fmt.Errorf("journal sequence %d is not after %d", record.Sequence, j.last)
+	}
+
+	current := j.segments[len(j.segments)-1]
+	if current.size >= j.options.SegmentSize {
+		err := j.rotate(record.Sequence)
+		if err != nil {
+			return err
+		}
+		current = j.segments[len(j.segments)-1]
+	}
+
+	j.buffer = encodeRecord(j.buffer, record)
+
+	written, err := j.active.Write(j.buffer)
+	if err != nil {
+		// do not leave a torn record in front of the next one
+		if written > 0 {
+			_ = j.active.Truncate(current.size)
+		}
+		return err
+	}
+
+	current.size += int64(written)
+	j.last = record.Sequence
+
+	if j.options.SyncInterval == 0 {
+		return j.active.Sync()
+	}
+	j.dirty = true
+
+	return nil
+}
+
+// rotate must be called with the mutex held.
+func (j *Journal) rotate(first uint64) error {
+	err := j.active.Sync()
+	if err != nil {
+		return err
+	}
+
+	err = j.active.Close()
+	if err != nil {
+		return err
+	}
+	j.active = nil
+	j.dirty = false
+
+	err = j.openSegment(first)
+	if err != nil {
+		return err
+	}
+
+	j.removeExpired()
+
+	return nil
+}
+
+// removeExpired drops the oldest segments over the size or the age limit, must
+// be called with the mutex held.
+func (j *Journal) removeExpired() {
+	var total int64
+	for _, segment := range j.segments {
+		total += segment.size
+	}
+
+	for len(j.segments) > 1 {
+		oldest := j.segments[0]
+
+		expired := j.options.MaxSize > 0 && total > j.options.MaxSize
+		if !expired && j.options.MaxAge > 0 {
+			// the file is written last when its last record is appended
+			info, err := os.Stat(oldest.path)
+			expired = err == nil && time.Since(info.ModTime()) > j.options.MaxAge
+		}
+		if !expired {
+			return
+		}
+
+		err := os.Remove(oldest.path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			j.localLogger.Error().Err(err).Msgf("Journal segment %s removal failed", oldest.path)
+			return
+		}
+
+		j.localLogger.Info().Msgf("Journal segment %s removed by retention", oldest.path)
+		total -= oldest.size
+		j.segments = j.segments[1:]
+	}
+}
+
+// first retained sequence, last+1 when the journal is empty, must be called with the mutex held.
+func (j *Journal) first() uint64 {
+	return j.segments[0].first
+}
+
+// Range returns the first and the last retained sequences, first is greater
+// than last when nothing is retained. Sequences in between may have holes.
+func (j *Journal) Range() (uint64, uint64) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	return j.first(), j.last
+}
+
+// Replay visits the records from..to in order, 0 from means the oldest retained
+// record and 0 to means the last record at the time of the call. It stops at
+// the first error returned by visit.
+func (j *Journal) Replay(from uint64, to uint64, visit func(Record) error) error {
+	j.mutex.Lock()
+	first, last := j.first(), j.last
+	segments := make([]segment, 0, len(j.segments))
+	for _, segment := range j.segments {
+		segments = append(segments, *segment)
+	}
+	j.mutex.Unlock()
+
+	if from == 0 {
+		from = first
+	}
+	if to == 0 || to > last {
+		to = last
+	}
+	if from < first || from > last+1 {
+		return fmt.Errorf("%w: %d is not in %d-%d", ErrOutOfRange, from, first, last)
+	}
+
+	// the last segment starting at or before from
+	index := sort.Search(len(segments), func(i int) bool {
+		return segments[i].first > from
+	}) - 1
+
+	for _, segment := range segments[index:] {
+		if segment.first > to {
+			return nil
+		}
+
+		err := replaySegment(segment, from, to, visit)
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%w: segment %d is removed by retention", ErrOutOfRange, segment.first)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replaySegment(segment segment, from uint64, to uint64, visit func(Record) error) error {
+	file, err := os.Open(segment.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// the size is taken under the mutex, the active segment may be longer already
+	reader := newSegmentReader(io.LimitReader(file, segment.size))
+	for {
+		record, _, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("journal segment %s: %w", segment.path, err)
+		}
+
+		if record.Sequence < from {
+			continue
+		}
+		if record.Sequence > to {
+			return nil
+		}
+
+		err = visit(record)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Run syncs appended records and applies the age limit while nothing is
+// appended, it returns when ctx is done.
+func (j *Journal) Run(ctx context.Context) {
+	interval := j.options.SyncInterval
+	if interval == 0 || interval > retentionInterval {
+		interval = retentionInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastRetention := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		j.mutex.Lock()
+		if j.dirty && j.active != nil {
+			err := j.active.Sync()
+			if err != nil {
+				j.localLogger.Error().Err(err).Msg("Journal sync failed")
+			}
+			j.dirty = false
+		}
+		if time.Since(lastRetention) >= retentionInterval {
+			j.removeExpired()
+			lastRetention = time.Now()
+		}
+		j.mutex.Unlock()
+	}
+}
+
+func (j *Journal) Close() error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.active == nil {
+		return nil
+	}
+
+	err := j.active.Sync()
+	if err != nil {
+		_ = j.active.Close()
+		j.active = nil
+		return err
+	}
+
+	err = j.active.Close()
+	j.active = nil
+
+	return err
+}
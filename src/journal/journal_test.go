@@ -0,0 +1,422 @@
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rs/zerolog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testRecordSize is the encoded size of the records appended by appendRecords.
+const testRecordSize = headerSize + len("message-00")
+
+func openJournal(t *testing.T, options Options) *Journal {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	j, err := NewJournal(&logger, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = j.Close()
+	})
+
+	return j
+}
+
+func testRecord(sequence uint64) Record {
+	return Record{
+		Sequence: sequence,
+		Time:     time.Unix(1700000000, int64(sequence)),
+		Data:     fmt.Sprintf("message-%02d", sequence),
+	}
+}
+
+func appendRecords(t *testing.T, j *Journal, from uint64, to uint64) {
+	t.Helper()
+
+	for sequence := from; sequence <= to; sequence++ {
+		err := j.Append(testRecord(sequence))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func replaySequences(j *Journal, from uint64, to uint64) ([]uint64, error) {
+	var sequences []uint64
+	err := j.Replay(from, to, func(record Record) error {
+		if record != testRecord(record.Sequence) {
+			return fmt.Errorf("record %d is %+v", record.Sequence, record)
+		}
+		sequences = append(sequences, record.Sequence)
+		return nil
+	})
+
+	return sequences, err
+}
+
+func expectSequences(t *testing.T, got []uint64, from uint64, to uint64) {
+	t.Helper()
+
+	if from > to && len(got) == 0 {
+		return
+	}
+	if uint64(len(got)) != to-from+1 {
+		t.Fatalf("replayed %v, want %d-%d", got, from, to)
+	}
+	for i, sequence := range got {
+		if sequence != from+uint64(i) {
+			t.Fatalf("replayed %v, want %d-%d", got, from, to)
+		}
+	}
+}
+
+func TestJournalRecovery(t *testing.T) {
+	tests := []struct {
+		name string
+		// damages the segment holding records 1-5
+		damage func(t *testing.T, path string)
+		last   uint64
+	}{
+		{
+			name:   "intact",
+			damage: func(t *testing.T, path string) {},
+			last:   5,
+		},
+		{
+			name: "torn header",
+			damage: func(t *testing.T, path string) {
+				truncate(t, path, int64(4*testRecordSize+headerSize/2))
+			},
+			last: 4,
+		},
+		{
+			name: "torn payload",
+			damage: func(t *testing.T, path string) {
+				truncate(t, path, int64(5*testRecordSize-3))
+			},
+			last: 4,
+		},
+		{
+			name: "crc mismatch",
+			damage: func(t *testing.T, path string) {
+				flipByte(t, path, int64(4*testRecordSize+headerSize+1))
+			},
+			last: 4,
+		},
+		{
+			name: "corrupted middle record",
+			damage: func(t *testing.T, path string) {
+				flipByte(t, path, int64(2*testRecordSize+headerSize))
+			},
+			last: 2,
+		},
+		{
+			name: "garbage tail",
+			damage: func(t *testing.T, path string) {
+				file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer file.Close()
+				_, err = file.Write([]byte{1, 2, 3})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			last: 5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			directory := t.TempDir()
+			options := Options{Directory: directory, SegmentSize: 1 << 20}
+
+			j := openJournal(t, options)
+			appendRecords(t, j, 1, 5)
+			err := j.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := segmentPath(directory, 1)
+			test.damage(t, path)
+
+			j = openJournal(t, options)
+			first, last := j.Range()
+			if first != 1 || last != test.last {
+				t.Fatalf("range %d-%d, want 1-%d", first, last, test.last)
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Size() != int64(test.last)*int64(testRecordSize) {
+				t.Fatalf("segment is %d bytes, want %d records", info.Size(), test.last)
+			}
+
+			// numbering continues after the last complete record
+			appendRecords(t, j, test.last+1, test.last+2)
+			sequences, err := replaySequences(j, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectSequences(t, sequences, 1, test.last+2)
+		})
+	}
+}
+
+func TestJournalAppendOrder(t *testing.T) {
+	j := openJournal(t, Options{Directory: t.TempDir(), SegmentSize: 1 << 20})
+	appendRecords(t, j, 1, 3)
+
+	err := j.Append(testRecord(3))
+	if err == nil {
+		t.Fatal("a repeated sequence is appended")
+	}
+
+	// a sequence that failed to be written leaves a hole
+	appendRecords(t, j, 5, 5)
+	sequences, err := replaySequences(j, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sequences) != 4 || sequences[3] != 5 {
+		t.Fatalf("replayed %v, want 1-3 and 5", sequences)
+	}
+}
+
+func TestJournalRotation(t *testing.T) {
+	directory := t.TempDir()
+	// three records fit into a segment before it is over the size
+	options := Options{Directory: directory, SegmentSize: int64(3 * testRecordSize)}
+
+	j := openJournal(t, options)
+	appendRecords(t, j, 1, 10)
+
+	segments, err := listSegments(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var firsts []uint64
+	for _, segment := range segments {
+		firsts = append(firsts, segment.first)
+	}
+	if fmt.Sprint(firsts) != fmt.Sprint([]uint64{1, 4, 7, 10}) {
+		t.Fatalf("segments start with %v, want [1 4 7 10]", firsts)
+	}
+
+	sequences, err := replaySequences(j, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSequences(t, sequences, 1, 10)
+
+	// the numbering survives a restart
+	err = j.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	j = openJournal(t, options)
+	if first, last := j.Range(); first != 1 || last != 10 {
+		t.Fatalf("range %d-%d after restart, want 1-10", first, last)
+	}
+}
+
+func TestJournalRetention(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		// prepares the closed journal before it is opened again
+		prepare func(t *testing.T, directory string)
+		first   uint64
+	}{
+		{
+			name:    "unlimited",
+			options: Options{},
+			first:   1,
+		},
+		{
+			name: "size",
+			// two full segments and the active one
+			options: Options{MaxSize: int64(7 * testRecordSize)},
+			first:   4,
+		},
+		{
+			name:    "size never removes the active segment",
+			options: Options{MaxSize: 1},
+			first:   10,
+		},
+		{
+			name:    "age",
+			options: Options{MaxAge: time.Hour},
+			prepare: func(t *testing.T, directory string) {
+				old := time.Now().Add(-time.Hour * 2)
+				for _, first := range []uint64{1, 4} {
+					err := os.Chtimes(segmentPath(directory, first), old, old)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			},
+			first: 7,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			directory := t.TempDir()
+			options := Options{Directory: directory, SegmentSize: int64(3 * testRecordSize)}
+
+			j := openJournal(t, options)
+			appendRecords(t, j, 1, 10)
+			err := j.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.prepare != nil {
+				test.prepare(t, directory)
+			}
+
+			// the limits are applied on open
+			options.MaxSize = test.options.MaxSize
+			options.MaxAge = test.options.MaxAge
+			j = openJournal(t, options)
+
+			first, last := j.Range()
+			if first != test.first || last != 10 {
+				t.Fatalf("range %d-%d, want %d-10", first, last, test.first)
+			}
+			if _, err := os.Stat(segmentPath(directory, test.first)); err != nil {
+				t.Fatalf("first retained segment: %s", err)
+			}
+
+			sequences, err := replaySequences(j, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectSequences(t, sequences, test.first, 10)
+
+			if test.first > 1 {
+				_, err = replaySequences(j, test.first-1, 0)
+				if !errors.Is(err, ErrOutOfRange) {
+					t.Fatalf("replay of a removed sequence: %v, want out of range", err)
+				}
+			}
+		})
+	}
+}
+
+func TestJournalRetentionOnRotate(t *testing.T) {
+	directory := t.TempDir()
+	j := openJournal(t, Options{
+		Directory:   directory,
+		SegmentSize: int64(3 * testRecordSize),
+		MaxSize:     int64(7 * testRecordSize),
+	})
+	appendRecords(t, j, 1, 13)
+
+	// the rotation to 13 applies the limit to 1-12, 7-12 fit into it
+	if first, last := j.Range(); first != 7 || last != 13 {
+		t.Fatalf("range %d-%d, want 7-13", first, last)
+	}
+	if matches, _ := filepath.Glob(filepath.Join(directory, "*"+segmentExtension)); len(matches) != 3 {
+		t.Fatalf("%d segment files, want 3", len(matches))
+	}
+}
+
+func TestJournalReplay(t *testing.T) {
+	j := openJournal(t, Options{Directory: t.TempDir(), SegmentSize: int64(3 * testRecordSize)})
+	appendRecords(t, j, 1, 10)
+
+	tests := []struct {
+		name string
+		from uint64
+		to   uint64
+		// the expected range, empty when wantFrom > wantTo
+		wantFrom uint64
+		wantTo   uint64
+		err      error
+	}{
+		{name: "all", from: 0, to: 0, wantFrom: 1, wantTo: 10},
+		{name: "first", from: 1, to: 0, wantFrom: 1, wantTo: 10},
+		{name: "middle of a segment", from: 5, to: 0, wantFrom: 5, wantTo: 10},
+		{name: "segment boundary", from: 7, to: 0, wantFrom: 7, wantTo: 10},
+		{name: "bounded", from: 2, to: 8, wantFrom: 2, wantTo: 8},
+		{name: "to over the last", from: 9, to: 20, wantFrom: 9, wantTo: 10},
+		{name: "last", from: 10, to: 0, wantFrom: 10, wantTo: 10},
+		{name: "last+1 is empty", from: 11, to: 0, wantFrom: 11, wantTo: 10},
+		{name: "last+2 is not written yet", from: 12, to: 0, err: ErrOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sequences, err := replaySequences(j, test.from, test.to)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("got %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectSequences(t, sequences, test.wantFrom, test.wantTo)
+		})
+	}
+}
+
+func TestJournalReplayStops(t *testing.T) {
+	j := openJournal(t, Options{Directory: t.TempDir(), SegmentSize: 1 << 20})
+	appendRecords(t, j, 1, 5)
+
+	stop := errors.New("stop")
+	var visited int
+	err := j.Replay(0, 0, func(record Record) error {
+		visited++
+		if record.Sequence == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || visited != 3 {
+		t.Fatalf("got %v after %d records, want stop after 3", err, visited)
+	}
+}
+
+func truncate(t *testing.T, path string, size int64) {
+	t.Helper()
+
+	err := os.Truncate(path, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func flipByte(t *testing.T, path string, offset int64) {
+	t.Helper()
+
+	file, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	b := make([]byte, 1)
+	_, err = file.ReadAt(b, offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[0] ^= 0xff
+	_, err = file.WriteAt(b, offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
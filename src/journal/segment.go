@@ -0,0 +1,189 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	segmentExtension = ".journal"
+	// payload size, crc, sequence, receive time in unix nanoseconds
+	headerSize = 4 + 4 + 8 + 8
+	// a message bigger than that is a corrupted header
+	maxRecordSize = 64 << 20
+)
+
+var errCorrupted = errors.New("corrupted journal record")
+
+type Record struct {
+	Sequence uint64
+	Time     time.Time
+	Data     string
+}
+
+type segment struct {
+	// sequence of the first record, the file is named after it
+	first uint64
+	path  string
+	size  int64
+}
+
+func segmentPath(directory string, first uint64) string {
+	return filepath.Join(directory, fmt.Sprintf("%020d%s", first, segmentExtension))
+}
+
+// listSegments returns the segments of the directory ordered by sequence.
+func listSegments(directory string) ([]*segment, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []*segment
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExtension) {
+			continue
+		}
+
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExtension), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, &segment{
+			first: first,
+			path:  filepath.Join(directory, name),
+			size:  info.Size(),
+		})
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].first < segments[j].first
+	})
+
+	return segments, nil
+}
+
+func encodeRecord(buffer []byte, record Record) []byte {
+	size := headerSize + len(record.Data)
+	if cap(buffer) < size {
+		buffer = make([]byte, size)
+	}
+	buffer = buffer[:size]
+
+	binary.LittleEndian.PutUint32(buffer[0:], uint32(len(record.Data)))
+	binary.LittleEndian.PutUint64(buffer[8:], record.Sequence)
+	binary.LittleEndian.PutUint64(buffer[16:], uint64(record.Time.UnixNano()))
+	copy(buffer[headerSize:], record.Data)
+	binary.LittleEndian.PutUint32(buffer[4:], crc32.ChecksumIEEE(buffer[8:]))
+
+	return buffer
+}
+
+// segmentReader reads records one by one, io.EOF is returned at the end of the
+// segment and io.ErrUnexpectedEOF for a record cut short.
+type segmentReader struct {
+	reader *bufio.Reader
+	header []byte
+	buffer []byte
+}
+
+func newSegmentReader(reader io.Reader) *segmentReader {
+	return &segmentReader{
+		reader: bufio.NewReaderSize(reader, 64<<10),
+		header: make([]byte, headerSize),
+	}
+}
+
+// next returns the record and its encoded size.
+func (r *segmentReader) next() (Record, int64, error) {
+	_, err := io.ReadFull(r.reader, r.header)
+	if err != nil {
+		return Record{}, 0, err
+	}
+
+	size := binary.LittleEndian.Uint32(r.header[0:])
+	if size > maxRecordSize {
+		return Record{}, 0, errCorrupted
+	}
+	if cap(r.buffer) < int(size) {
+		r.buffer = make([]byte, size)
+	}
+	data := r.buffer[:size]
+
+	_, err = io.ReadFull(r.reader, data)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return Record{}, 0, io.ErrUnexpectedEOF
+		}
+		return Record{}, 0, err
+	}
+
+	checksum := crc32.Update(crc32.ChecksumIEEE(r.header[8:]), crc32.IEEETable, data)
+	if checksum != binary.LittleEndian.Uint32(r.header[4:]) {
+		return Record{}, 0, errCorrupted
+	}
+
+	return Record{
+		Sequence: binary.LittleEndian.Uint64(r.header[8:]),
+		Time:     time.Unix(0, int64(binary.LittleEndian.Uint64(r.header[16:]))),
+		Data:     string(data),
+	}, headerSize + int64(size), nil
+}
+
+// recoverSegment finds the last complete record of the segment written before
+// a crash and cuts off whatever follows it. It returns the last sequence,
+// first-1 when the segment is empty.
+func recoverSegment(segment *segment) (uint64, error) {
+	file, err := os.OpenFile(segment.path, os.O_RDWR, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader := newSegmentReader(file)
+	last := segment.first - 1
+	var valid int64
+
+	for {
+		record, size, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, errCorrupted) {
+			return 0, err
+		}
+		if err != nil || record.Sequence <= last {
+			break
+		}
+
+		last = record.Sequence
+		valid += size
+	}
+
+	if valid != segment.size {
+		err = file.Truncate(valid)
+		if err != nil {
+			return 0, err
+		}
+		segment.size = valid
+	}
+
+	return last, nil
+}
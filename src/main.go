@@ -1,5 +1,3 @@
-//go:build windows && amd64
-
 package main
 
 import (
@@ -7,34 +5,63 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"flag"
 	"github.com/TrueGameover/transaq-grpc/src/client"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/config"
 	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
 	"github.com/TrueGameover/transaq-grpc/src/queue"
 	"github.com/TrueGameover/transaq-grpc/src/server"
 	"github.com/TrueGameover/transaq-grpc/src/transaq"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"golang.org/x/sys/windows"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"net"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 )
 
-const PoolSize = 10000
-
 func main() {
 	appLogger := configureLogger()
+
+	appConfig, err := config.Load(os.Args[1:], os.LookupEnv)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		appLogger.Fatal().Err(err).Msg("Invalid configuration")
+	}
+	appLogger.Info().Msgf("Effective configuration:\n%s", appConfig.Redacted())
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	fixedQueue := queue.NewFixedQueue[string](ctx, PoolSize)
-	transaqHandler := transaq.NewTransaqHandler(appLogger, fixedQueue)
+	messagesQueue := queue.NewLanedQueue[transaq.Message](appConfig.Server.CriticalPoolSize, appConfig.Server.PoolSize)
+	connector, err := setupConnector(appLogger, appConfig)
+	if err != nil {
+		panic(err)
+	}
+	messagesJournal, err := setupJournal(ctx, appLogger, appConfig.Journal)
+	if err != nil {
+		appLogger.Fatal().Err(err).Msg("Journal initialization failed")
+	}
+	if messagesJournal != nil {
+		defer func() {
+			err := messagesJournal.Close()
+			if err != nil {
+				appLogger.Error().Err(err).Msg("Journal close failed")
+			}
+		}()
+	}
+
+	transaqHandler := transaq.NewTransaqHandler(appLogger, connector, messagesQueue, messagesJournal)
 	clientExists := client.NewClientExists()
 
-	err := transaqHandler.Init(ctx, clientExists)
+	err = transaqHandler.Init(ctx, clientExists)
 	if err != nil {
 		panic(err)
 	}
@@ -44,21 +71,52 @@ func main() {
 		}
 	}()
 
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	subscriptions := transaq.NewSubscriptionManager(appLogger, transaqHandler)
+	go subscriptions.Run(ctx)
+	supervisor := transaq.NewSupervisor(appLogger, transaqHandler, subscriptions, appConfig.Supervisor.SupervisorConfig())
+	go supervisor.Run(ctx)
+	catalog := transaq.NewCatalog(appLogger, transaqHandler)
+	orderBooks := transaq.NewOrderBooks(appLogger, transaqHandler)
+	go orderBooks.Run(ctx)
+	orderTracker := transaq.NewOrderTracker(appLogger, transaqHandler)
+
+	if appConfig.Session.Login != "" {
+		connectOnStart(appLogger, transaqHandler, appConfig.Session)
+	}
+
+	lis, err := net.Listen("tcp", appConfig.Server.Listen)
 	if err != nil {
 		appLogger.Panic().Err(err)
 	}
 
-	tlsOptions, err := setupTlsConfiguration()
-	if err != nil {
-		appLogger.Warn().Err(err).Msg("Tls initialization failed. Skipping...")
-		tlsOptions = []grpc.ServerOption{}
+	tlsOptions := []grpc.ServerOption{}
+	if appConfig.Tls.Enabled {
+		tlsOptions, err = setupTlsConfiguration(appConfig.Tls)
+		if err != nil && appConfig.Tls.Required {
+			appLogger.Fatal().Err(err).Msg("Tls initialization failed")
+		}
+		if err != nil {
+			appLogger.Warn().Err(err).Msg("Tls initialization failed. Skipping...")
+			tlsOptions = []grpc.ServerOption{}
+		}
+	} else {
+		appLogger.Warn().Msg("Tls is disabled, grpc traffic is not encrypted")
 	}
 
 	srv := grpc.NewServer(tlsOptions...)
 	SetupCloseHandler(srv, appLogger, cancel)
 
-	server2.RegisterConnectServiceServer(srv, server.NewConnectService(transaqHandler, fixedQueue, clientExists, appLogger))
+	server2.RegisterConnectServiceServer(srv, server.NewConnectService(
+		transaqHandler,
+		messagesQueue,
+		messagesJournal,
+		subscriptions,
+		catalog,
+		orderBooks,
+		orderTracker,
+		clientExists,
+		appLogger,
+	))
 
 	appLogger.Info().Msg("Press CRTL+C to stop the ConnectService...")
 
@@ -68,9 +126,67 @@ func main() {
 	}
 }
 
+func setupConnector(logger *zerolog.Logger, appConfig *config.Config) (transaq.Connector, error) {
+	var connector transaq.Connector
+
+	if appConfig.Connector.Kind == config.ConnectorReplay {
+		speed, err := appConfig.Connector.Speed()
+		if err != nil {
+			return nil, err
+		}
+
+		replay, err := transaq.NewReplayConnector(logger, appConfig.Connector.ReplayFile, speed)
+		if err != nil {
+			return nil, err
+		}
+		connector = replay
+	} else {
+		connector = newConnector(appConfig.Connector, appConfig.Server.PoolSize, logger)
+	}
+
+	if appConfig.Connector.CaptureDir != "" {
+		capture, err := transaq.NewCaptureWriter(appConfig.Connector.CaptureDir)
+		if err != nil {
+			return nil, err
+		}
+		connector = transaq.NewRecordingConnector(logger, connector, capture)
+	}
+
+	return connector, nil
+}
+
+// setupJournal returns nil when the journal is disabled.
+func setupJournal(ctx context.Context, logger *zerolog.Logger, journalConfig config.Journal) (*journal.Journal, error) {
+	if !journalConfig.Enabled {
+		logger.Warn().Msg("Journal is disabled, messages are kept in memory only")
+		return nil, nil
+	}
+
+	messagesJournal, err := journal.NewJournal(logger, journalConfig.Options())
+	if err != nil {
+		return nil, err
+	}
+	go messagesJournal.Run(ctx)
+
+	return messagesJournal, nil
+}
+
+func connectOnStart(logger *zerolog.Logger, handler *transaq.TransaqHandler, session config.Session) {
+	_, err := handler.Send(commands.Connect{
+		Login:    session.Login,
+		Password: session.Password,
+		Host:     session.Host,
+		Port:     session.Port,
+	})
+	if err != nil {
+		// the supervisor does not know the session yet, clients can connect later
+		logger.Error().Err(err).Msgf("Connect to %s:%d on start failed", session.Host, session.Port)
+	}
+}
+
 func SetupCloseHandler(srv *grpc.Server, localLogger *zerolog.Logger, appCancelFunc context.CancelFunc) {
-	c := make(chan os.Signal)
-	signal.Notify(c, os.Interrupt, windows.SIGTERM)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-c
 		localLogger.Warn().Msg("Ctrl+C pressed in Terminal")
@@ -89,29 +205,36 @@ func configureLogger() *zerolog.Logger {
 	return &zeroLogger
 }
 
-func setupTlsConfiguration() ([]grpc.ServerOption, error) {
+func setupTlsConfiguration(tlsConfiguration config.Tls) ([]grpc.ServerOption, error) {
 	var opts []grpc.ServerOption
 
-	rootCa, err := os.ReadFile("certs/rootCA.crt")
-	if err != nil {
-		return nil, err
-	}
-	caPool := x509.NewCertPool()
-	if !caPool.AppendCertsFromPEM(rootCa) {
-		return nil, errors.New("cannot append rootCA to cert pool")
-	}
-
-	serverCert, err := tls.LoadX509KeyPair("certs/transaqGrpcServiceServer.crt", "certs/transaqGrpcServiceServer.key")
+	serverCert, err := tls.LoadX509KeyPair(tlsConfiguration.CertFile, tlsConfiguration.KeyFile)
 	if err != nil {
 		return nil, err
 	}
 
 	tlsConfig := tls.Config{
 		Certificates: []tls.Certificate{serverCert},
-		ClientCAs:    caPool,
-		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientAuth:   tls.NoClientCert,
 		MinVersion:   tls.VersionTLS13,
 	}
+	if tlsConfiguration.MinVersion == config.TlsVersion12 {
+		tlsConfig.MinVersion = tls.VersionTLS12
+	}
+
+	if tlsConfiguration.ClientAuth {
+		rootCa, err := os.ReadFile(tlsConfiguration.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		caPool := x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(rootCa) {
+			return nil, errors.New("cannot append rootCA to cert pool")
+		}
+
+		tlsConfig.ClientCAs = caPool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
 
 	opts = append(opts, grpc.Creds(credentials.NewTLS(&tlsConfig)))
 
@@ -0,0 +1,83 @@
+package messages
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// Entity is a child element of a list message kept as raw XML, so that it can
+// be stored and sent again without knowing every field.
+type Entity struct {
+	Name string
+	Raw  string
+	// attributes and the text of flat children
+	values map[string]string
+}
+
+func (e *Entity) Value(name string) string {
+	return e.values[name]
+}
+
+// SplitEntities returns the children of the root element in order.
+func SplitEntities(data string) ([]Entity, error) {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+
+	var entities []Entity
+	var current *Entity
+	var start int64
+	var leaf string
+	var text strings.Builder
+	depth := 0
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			switch depth {
+			case 2:
+				current = &Entity{Name: t.Name.Local, values: map[string]string{}}
+				for _, attr := range t.Attr {
+					current.values[attr.Name.Local] = attr.Value
+				}
+				start = offset
+			case 3:
+				leaf = t.Name.Local
+				text.Reset()
+			default:
+				leaf = ""
+			}
+
+		case xml.CharData:
+			if leaf != "" {
+				text.Write(t)
+			}
+
+		case xml.EndElement:
+			switch depth {
+			case 2:
+				current.Raw = data[start:decoder.InputOffset()]
+				entities = append(entities, *current)
+				current = nil
+			case 3:
+				if leaf != "" {
+					current.values[leaf] = strings.TrimSpace(text.String())
+				}
+			}
+			leaf = ""
+			depth--
+		}
+	}
+
+	return entities, nil
+}
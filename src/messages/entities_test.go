@@ -0,0 +1,74 @@
+package messages
+
+import (
+	"testing"
+)
+
+func TestSplitEntities(t *testing.T) {
+	data := `<securities>` +
+		`<security secid="1" active="true"><seccode> SBER </seccode><board>TQBR</board>` +
+		`<opmask usecredit="yes"/><nested><seccode>GAZP</seccode></nested></security>` +
+		`<security secid="2"><seccode>GAZP</seccode></security>` +
+		`<removed secid="3"/>` +
+		`</securities>`
+
+	entities, err := SplitEntities(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		raw    string
+		values map[string]string
+	}{
+		{
+			name: "security",
+			raw: `<security secid="1" active="true"><seccode> SBER </seccode><board>TQBR</board>` +
+				`<opmask usecredit="yes"/><nested><seccode>GAZP</seccode></nested></security>`,
+			// the deeper elements are not flat values
+			values: map[string]string{"secid": "1", "active": "true", "seccode": "SBER", "board": "TQBR", "usecredit": "", "nested": ""},
+		},
+		{
+			name:   "security",
+			raw:    `<security secid="2"><seccode>GAZP</seccode></security>`,
+			values: map[string]string{"secid": "2", "seccode": "GAZP", "board": ""},
+		},
+		{
+			name:   "removed",
+			raw:    `<removed secid="3"/>`,
+			values: map[string]string{"secid": "3"},
+		},
+	}
+
+	if len(entities) != len(tests) {
+		t.Fatalf("got %d entities, want %d", len(entities), len(tests))
+	}
+	for i, test := range tests {
+		entity := entities[i]
+		if entity.Name != test.name || entity.Raw != test.raw {
+			t.Fatalf("entity %d is %s %q, want %s %q", i, entity.Name, entity.Raw, test.name, test.raw)
+		}
+		for name, want := range test.values {
+			if got := entity.Value(name); got != want {
+				t.Errorf("entity %d %s is %q, want %q", i, name, got, want)
+			}
+		}
+	}
+}
+
+func TestSplitEntitiesEmpty(t *testing.T) {
+	for _, data := range []string{`<orders/>`, `<orders></orders>`, `<orders> </orders>`} {
+		entities, err := SplitEntities(data)
+		if err != nil || len(entities) != 0 {
+			t.Errorf("SplitEntities(%q) got %v %v, want nothing", data, entities, err)
+		}
+	}
+}
+
+func TestSplitEntitiesMalformed(t *testing.T) {
+	_, err := SplitEntities(`<orders><order transactionid="1"></orders>`)
+	if err == nil {
+		t.Fatal("a malformed message is split")
+	}
+}
@@ -0,0 +1,282 @@
+package messages
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// listRoots carry an entity per child element, any other root is an entity itself.
+var listRoots = map[string]bool{
+	RootMarkets:     true,
+	RootBoards:      true,
+	RootCandleKinds: true,
+	RootSecurities:  true,
+	RootPits:        true,
+	RootQuotations:  true,
+	RootAllTrades:   true,
+	RootQuotes:      true,
+	RootTicks:       true,
+	RootOrders:      true,
+	RootTrades:      true,
+	RootPositions:   true,
+	RootMessages:    true,
+	RootMaxBuySell:  true,
+}
+
+type SecurityKey struct {
+	SecCode string
+	// empty matches every board
+	Board string
+}
+
+// Filter keeps the messages of the given types and the entities of the given
+// securities, clients and markets. Every set criterion must match, an entity
+// that does not carry the field of a criterion passes it: a security filter
+// keeps money positions and server_status, a client filter keeps quotes. The
+// connection state is never filtered out this way, the types criterion drops
+// the rest.
+type Filter struct {
+	types      map[string]struct{}
+	securities []SecurityKey
+	clients    map[string]struct{}
+	markets    map[string]struct{}
+}
+
+// entityFields are the filtered fields found in an entity, its attributes or
+// its nested elements.
+type entityFields struct {
+	secCodes []string
+	boards   []string
+	clients  []string
+	markets  []string
+}
+
+func NewFilter(types []string, securities []SecurityKey, clients []string, markets []string) (*Filter, error) {
+	filter := &Filter{
+		types:      set(types),
+		securities: securities,
+		clients:    set(clients),
+		markets:    set(markets),
+	}
+
+	for root := range filter.types {
+		if _, ok := factories[root]; !ok {
+			return nil, fmt.Errorf("unknown message type %q", root)
+		}
+	}
+	for _, security := range securities {
+		if security.SecCode == "" {
+			return nil, errors.New("security filter without seccode")
+		}
+	}
+
+	return filter, nil
+}
+
+func set(values []string) map[string]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		result[value] = struct{}{}
+	}
+
+	return result
+}
+
+// Apply returns the message with the entities that pass the filter, false
+// when nothing passes. A message that can not be parsed is passed as is.
+func (f *Filter) Apply(root string, data string) (string, bool) {
+	if f.types != nil {
+		if _, ok := f.types[root]; !ok {
+			return "", false
+		}
+	}
+	if len(f.securities) == 0 && f.clients == nil && f.markets == nil {
+		return data, true
+	}
+
+	filtered, ok, err := f.filterEntities(root, data)
+	if err != nil {
+		return data, true
+	}
+
+	return filtered, ok
+}
+
+func (f *Filter) filterEntities(root string, data string) (string, bool, error) {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+	list := listRoots[root]
+
+	var rootFields, fields entityFields
+	var kept []string
+	var rootOpenEnd, rootCloseStart, entityStart int64
+	var entities int
+	var leaf string
+	var text strings.Builder
+	depth := 0
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return "", false, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			switch {
+			case depth == 1:
+				rootFields.addElement(t)
+				rootOpenEnd = decoder.InputOffset()
+			case depth == 2 && list:
+				fields = rootFields.copy()
+				fields.addElement(t)
+				entityStart = offset
+			default:
+				current := &rootFields
+				if list {
+					current = &fields
+				}
+				current.addElement(t)
+			}
+			leaf = t.Name.Local
+			text.Reset()
+
+		case xml.CharData:
+			if leaf != "" {
+				text.Write(t)
+			}
+
+		case xml.EndElement:
+			if leaf == t.Name.Local && depth > 1 {
+				current := &rootFields
+				if list {
+					current = &fields
+				}
+				current.addField(leaf, strings.TrimSpace(text.String()))
+			}
+			leaf = ""
+
+			switch {
+			case depth == 1:
+				rootCloseStart = offset
+			case depth == 2 && list:
+				entities++
+				if f.matches(&fields) {
+					kept = append(kept, data[entityStart:decoder.InputOffset()])
+				}
+			}
+			depth--
+		}
+	}
+
+	if !list {
+		return data, f.matches(&rootFields), nil
+	}
+	if len(kept) == entities {
+		return data, true, nil
+	}
+	if len(kept) == 0 {
+		return "", false, nil
+	}
+
+	builder := &strings.Builder{}
+	builder.WriteString(data[:rootOpenEnd])
+	for _, entity := range kept {
+		builder.WriteString(entity)
+	}
+	builder.WriteString(data[rootCloseStart:])
+
+	return builder.String(), true, nil
+}
+
+func (f *Filter) matches(fields *entityFields) bool {
+	if f.clients != nil && len(fields.clients) > 0 && !anyIn(fields.clients, f.clients) {
+		return false
+	}
+	if f.markets != nil && len(fields.markets) > 0 && !anyIn(fields.markets, f.markets) {
+		return false
+	}
+	if len(f.securities) == 0 || len(fields.secCodes) == 0 {
+		return true
+	}
+
+	for _, security := range f.securities {
+		if !contains(fields.secCodes, security.SecCode) {
+			continue
+		}
+		if security.Board == "" || len(fields.boards) == 0 || contains(fields.boards, security.Board) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func anyIn(values []string, filter map[string]struct{}) bool {
+	for _, value := range values {
+		if _, ok := filter[value]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addElement takes the filtered attributes, the id of client, market and board
+// elements names the entity itself.
+func (e *entityFields) addElement(element xml.StartElement) {
+	for _, attr := range element.Attr {
+		name := attr.Name.Local
+		if name == "id" {
+			name = element.Name.Local
+		}
+		e.addField(name, attr.Value)
+	}
+}
+
+func (e *entityFields) addField(name string, value string) {
+	if value == "" {
+		return
+	}
+
+	switch name {
+	case "seccode":
+		e.secCodes = append(e.secCodes, value)
+	case "board":
+		e.boards = append(e.boards, value)
+	case "client":
+		e.clients = append(e.clients, value)
+	case "market":
+		e.markets = append(e.markets, value)
+	}
+}
+
+func (e entityFields) copy() entityFields {
+	return entityFields{
+		secCodes: append([]string(nil), e.secCodes...),
+		boards:   append([]string(nil), e.boards...),
+		clients:  append([]string(nil), e.clients...),
+		markets:  append([]string(nil), e.markets...),
+	}
+}
@@ -0,0 +1,132 @@
+package messages
+
+import (
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	quotations := `<quotations>` +
+		`<quotation secid="1"><board>TQBR</board><seccode>SBER</seccode><last>250</last></quotation>` +
+		`<quotation secid="2"><board>TQBR</board><seccode>GAZP</seccode><last>160</last></quotation>` +
+		`<quotation secid="3"><board>SMAL</board><seccode>SBER</seccode><last>251</last></quotation>` +
+		`</quotations>`
+	orders := `<orders>` +
+		`<order transactionid="1"><client>C1</client><seccode>SBER</seccode><board>TQBR</board></order>` +
+		`<order transactionid="2"><client>C2</client><seccode>SBER</seccode><board>TQBR</board></order>` +
+		`</orders>`
+	positions := `<positions>` +
+		`<money_position><client>C1</client><asset>RUR</asset><markets><market>1</market></markets></money_position>` +
+		`<sec_position><client>C1</client><seccode>GAZP</seccode><market>1</market></sec_position>` +
+		`</positions>`
+	serverStatus := `<server_status connected="true"/>`
+
+	tests := []struct {
+		name       string
+		types      []string
+		securities []SecurityKey
+		clients    []string
+		markets    []string
+		root       string
+		data       string
+		// empty when the message is dropped
+		want string
+	}{
+		{name: "no criteria", root: RootQuotations, data: quotations, want: quotations},
+		{name: "type", types: []string{RootOrders}, root: RootOrders, data: orders, want: orders},
+		{name: "other type", types: []string{RootOrders}, root: RootQuotations, data: quotations},
+		{
+			name:       "security on every board",
+			securities: []SecurityKey{{SecCode: "SBER"}},
+			root:       RootQuotations,
+			data:       quotations,
+			want: `<quotations>` +
+				`<quotation secid="1"><board>TQBR</board><seccode>SBER</seccode><last>250</last></quotation>` +
+				`<quotation secid="3"><board>SMAL</board><seccode>SBER</seccode><last>251</last></quotation>` +
+				`</quotations>`,
+		},
+		{
+			name:       "security on a board",
+			securities: []SecurityKey{{SecCode: "SBER", Board: "SMAL"}, {SecCode: "GAZP", Board: "TQBR"}},
+			root:       RootQuotations,
+			data:       quotations,
+			want: `<quotations>` +
+				`<quotation secid="2"><board>TQBR</board><seccode>GAZP</seccode><last>160</last></quotation>` +
+				`<quotation secid="3"><board>SMAL</board><seccode>SBER</seccode><last>251</last></quotation>` +
+				`</quotations>`,
+		},
+		{name: "no security matches", securities: []SecurityKey{{SecCode: "LKOH"}}, root: RootQuotations, data: quotations},
+		{
+			// the money position has no seccode
+			name:       "security without seccode",
+			securities: []SecurityKey{{SecCode: "SBER"}},
+			root:       RootPositions,
+			data:       positions,
+			want:       `<positions><money_position><client>C1</client><asset>RUR</asset><markets><market>1</market></markets></money_position></positions>`,
+		},
+		{name: "security keeps server status", securities: []SecurityKey{{SecCode: "SBER"}}, root: RootServerStatus, data: serverStatus, want: serverStatus},
+		{
+			name:    "client",
+			clients: []string{"C2"},
+			root:    RootOrders,
+			data:    orders,
+			want:    `<orders><order transactionid="2"><client>C2</client><seccode>SBER</seccode><board>TQBR</board></order></orders>`,
+		},
+		{name: "client keeps quotations", clients: []string{"C2"}, root: RootQuotations, data: quotations, want: quotations},
+		{
+			name:  "client keeps nothing of its type",
+			types: []string{RootOrders, RootPositions}, clients: []string{"C3"},
+			root: RootOrders,
+			data: orders,
+		},
+		{name: "client element", clients: []string{"C1"}, root: RootClient, data: `<client id="C2"><type>spot</type></client>`},
+		{name: "market nested", markets: []string{"2"}, root: RootPositions, data: positions},
+		{name: "market", markets: []string{"1"}, root: RootPositions, data: positions, want: positions},
+		{
+			name:       "every criterion",
+			securities: []SecurityKey{{SecCode: "SBER"}},
+			clients:    []string{"C1"},
+			root:       RootOrders,
+			data:       orders,
+			want:       `<orders><order transactionid="1"><client>C1</client><seccode>SBER</seccode><board>TQBR</board></order></orders>`,
+		},
+		{
+			name:       "not a list",
+			securities: []SecurityKey{{SecCode: "SBER"}},
+			root:       RootSecInfo,
+			data:       `<sec_info secid="2"><seccode>GAZP</seccode><market>1</market></sec_info>`,
+		},
+		{
+			name:       "malformed passes as is",
+			securities: []SecurityKey{{SecCode: "SBER"}},
+			root:       RootOrders,
+			data:       `<orders><order>`,
+			want:       `<orders><order>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewFilter(test.types, test.securities, test.clients, test.markets)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := filter.Apply(test.root, test.data)
+			if ok != (test.want != "") || ok && got != test.want {
+				t.Fatalf("got %v\n%s\nwant\n%s", ok, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewFilterInvalid(t *testing.T) {
+	_, err := NewFilter([]string{"order"}, nil, nil, nil)
+	if err == nil {
+		t.Fatal("an unknown type is accepted")
+	}
+
+	_, err = NewFilter(nil, []SecurityKey{{Board: "TQBR"}}, nil, nil)
+	if err == nil {
+		t.Fatal("a security without seccode is accepted")
+	}
+}
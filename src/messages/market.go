@@ -0,0 +1,149 @@
+package messages
+
+import "encoding/xml"
+
+type Quotations struct {
+	XMLName xml.Name    `xml:"quotations"`
+	Items   []Quotation `xml:"quotation"`
+}
+
+// Quotation is an incremental update, fields missing from the message are nil.
+type Quotation struct {
+	SecId                 int             `xml:"secid,attr"`
+	Board                 string          `xml:"board"`
+	SecCode               string          `xml:"seccode"`
+	PointCost             *Decimal        `xml:"point_cost"`
+	AccruedIntValue       *Decimal        `xml:"accruedintvalue"`
+	Open                  *Decimal        `xml:"open"`
+	WaPrice               *Decimal        `xml:"waprice"`
+	BidDepth              *int64          `xml:"biddepth"`
+	BidDepthT             *int64          `xml:"biddeptht"`
+	NumBids               *int64          `xml:"numbids"`
+	OfferDepth            *int64          `xml:"offerdepth"`
+	OfferDepthT           *int64          `xml:"offerdeptht"`
+	Bid                   *Decimal        `xml:"bid"`
+	Offer                 *Decimal        `xml:"offer"`
+	NumOffers             *int64          `xml:"numoffers"`
+	NumTrades             *int64          `xml:"numtrades"`
+	VolToday              *int64          `xml:"voltoday"`
+	OpenPositions         *int64          `xml:"openpositions"`
+	DeltaPositions        *int64          `xml:"deltapositions"`
+	Last                  *Decimal        `xml:"last"`
+	Quantity              *int64          `xml:"quantity"`
+	Time                  *Time           `xml:"time"`
+	Change                *Decimal        `xml:"change"`
+	PriceMinusPrevWaPrice *Decimal        `xml:"priceminusprevwaprice"`
+	ValToday              *Decimal        `xml:"valtoday"`
+	Yield                 *Decimal        `xml:"yield"`
+	YieldAtWaPrice        *Decimal        `xml:"yieldatwaprice"`
+	MarketPriceToday      *Decimal        `xml:"marketpricetoday"`
+	HighBid               *Decimal        `xml:"highbid"`
+	LowOffer              *Decimal        `xml:"lowoffer"`
+	High                  *Decimal        `xml:"high"`
+	Low                   *Decimal        `xml:"low"`
+	ClosePrice            *Decimal        `xml:"closeprice"`
+	CloseYield            *Decimal        `xml:"closeyield"`
+	Status                *SecurityStatus `xml:"status"`
+	TradingStatus         *TradingStatus  `xml:"tradingstatus"`
+	BuyDeposit            *Decimal        `xml:"buydeposit"`
+	SellDeposit           *Decimal        `xml:"selldeposit"`
+	Volatility            *Decimal        `xml:"volatility"`
+	TheoreticalPrice      *Decimal        `xml:"theoreticalprice"`
+	BgoBuy                *Decimal        `xml:"bgo_buy"`
+	LCurrentPrice         *Decimal        `xml:"lcurrentprice"`
+}
+
+func (m *Quotations) RootElement() string {
+	return RootQuotations
+}
+
+type AllTrades struct {
+	XMLName xml.Name      `xml:"alltrades"`
+	Items   []MarketTrade `xml:"trade"`
+}
+
+type MarketTrade struct {
+	SecId        int     `xml:"secid,attr"`
+	SecCode      string  `xml:"seccode"`
+	Board        string  `xml:"board"`
+	TradeNo      int64   `xml:"tradeno"`
+	Time         Time    `xml:"time"`
+	Price        Decimal `xml:"price"`
+	Quantity     int64   `xml:"quantity"`
+	BuySell      BuySell `xml:"buysell"`
+	OpenInterest int64   `xml:"openinterest"`
+	Period       string  `xml:"period"`
+}
+
+func (m *AllTrades) RootElement() string {
+	return RootAllTrades
+}
+
+type Quotes struct {
+	XMLName xml.Name `xml:"quotes"`
+	Items   []Quote  `xml:"quote"`
+}
+
+// Quote is a single order book level change. Buy or Sell equal to -1 means
+// that the side of the level was removed, a missing side is nil.
+type Quote struct {
+	SecId   int     `xml:"secid,attr"`
+	Board   string  `xml:"board"`
+	SecCode string  `xml:"seccode"`
+	Price   Decimal `xml:"price"`
+	Source  string  `xml:"source"`
+	Yield   *int64  `xml:"yield"`
+	Buy     *int64  `xml:"buy"`
+	Sell    *int64  `xml:"sell"`
+}
+
+func (m *Quotes) RootElement() string {
+	return RootQuotes
+}
+
+type Ticks struct {
+	XMLName xml.Name `xml:"ticks"`
+	Items   []Tick   `xml:"tick"`
+}
+
+type Tick struct {
+	SecId        int     `xml:"secid"`
+	TradeNo      int64   `xml:"tradeno"`
+	TradeTime    Time    `xml:"tradetime"`
+	Price        Decimal `xml:"price"`
+	Quantity     int64   `xml:"quantity"`
+	Period       string  `xml:"period"`
+	BuySell      BuySell `xml:"buysell"`
+	OpenInterest int64   `xml:"openinterest"`
+	Board        string  `xml:"board"`
+	SecCode      string  `xml:"seccode"`
+}
+
+func (m *Ticks) RootElement() string {
+	return RootTicks
+}
+
+type Candles struct {
+	XMLName xml.Name `xml:"candles"`
+	SecId   int      `xml:"secid,attr"`
+	Board   string   `xml:"board,attr"`
+	SecCode string   `xml:"seccode,attr"`
+	Period  int      `xml:"period,attr"`
+	// 0 - no more data, 1 - more data will follow, 2 - history is complete, 3 - not available
+	Status int      `xml:"status,attr"`
+	Items  []Candle `xml:"candle"`
+}
+
+type Candle struct {
+	Date         Time    `xml:"date,attr"`
+	Open         Decimal `xml:"open,attr"`
+	High         Decimal `xml:"high,attr"`
+	Low          Decimal `xml:"low,attr"`
+	Close        Decimal `xml:"close,attr"`
+	Volume       int64   `xml:"volume,attr"`
+	OpenInterest int64   `xml:"oi,attr"`
+}
+
+func (m *Candles) RootElement() string {
+	return RootCandles
+}
@@ -0,0 +1,174 @@
+package messages
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// MergeElement is one quotation or quote of a callback message kept as raw
+// fields, so that partial updates of the same security can be merged without
+// knowing every field.
+type MergeElement struct {
+	// secid or board and seccode
+	Security string
+	// Security for quotations, Security with price and source for quotes
+	Key    string
+	Name   string
+	Attrs  []xml.Attr
+	Fields []MergeField
+}
+
+type MergeField struct {
+	Name  string
+	Value string
+}
+
+// Mergeable roots carry partial updates that stay correct when merged per key.
+func Mergeable(root string) bool {
+	return root == RootQuotations || root == RootQuotes
+}
+
+// SplitForMerge breaks quotations or quotes into elements, children are
+// expected to be flat text fields.
+func SplitForMerge(data string) ([]*MergeElement, error) {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+
+	var elements []*MergeElement
+	var current *MergeElement
+	var field *MergeField
+	depth := 0
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			switch depth {
+			case 2:
+				current = &MergeElement{Name: t.Name.Local, Attrs: t.Copy().Attr}
+			case 3:
+				current.Fields = append(current.Fields, MergeField{Name: t.Name.Local})
+				field = &current.Fields[len(current.Fields)-1]
+			case 4:
+				return nil, errors.New("nested element " + t.Name.Local + " can not be merged")
+			}
+
+		case xml.CharData:
+			if depth == 3 {
+				field.Value += string(t)
+			}
+
+		case xml.EndElement:
+			if depth == 2 {
+				current.keys()
+				elements = append(elements, current)
+				current = nil
+			}
+			field = nil
+			depth--
+		}
+	}
+
+	return elements, nil
+}
+
+func (e *MergeElement) keys() {
+	secId := e.attr("secid")
+	if secId != "" {
+		e.Security = secId
+	} else {
+		e.Security = e.field("board") + ":" + e.field("seccode")
+	}
+
+	e.Key = e.Security
+	if e.Name == "quote" {
+		e.Key += "|" + e.field("price") + "|" + e.field("source") + "|" + e.field("yield")
+	}
+}
+
+func (e *MergeElement) attr(name string) string {
+	for _, attr := range e.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+func (e *MergeElement) field(name string) string {
+	for _, field := range e.Fields {
+		if field.Name == name {
+			return field.Value
+		}
+	}
+
+	return ""
+}
+
+// Merge applies a newer update of the same key, its fields win.
+func (e *MergeElement) Merge(newer *MergeElement) {
+	for _, attr := range newer.Attrs {
+		found := false
+		for i := range e.Attrs {
+			if e.Attrs[i].Name == attr.Name {
+				e.Attrs[i].Value = attr.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			e.Attrs = append(e.Attrs, attr)
+		}
+	}
+
+	for _, field := range newer.Fields {
+		found := false
+		for i := range e.Fields {
+			if e.Fields[i].Name == field.Name {
+				e.Fields[i].Value = field.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			e.Fields = append(e.Fields, field)
+		}
+	}
+}
+
+// RenderMerged builds a callback message with the given root from elements.
+func RenderMerged(root string, elements []*MergeElement) string {
+	builder := &strings.Builder{}
+
+	builder.WriteString("<" + root + ">")
+	for _, element := range elements {
+		builder.WriteString("<" + element.Name)
+		for _, attr := range element.Attrs {
+			builder.WriteString(" " + attr.Name.Local + "=\"")
+			_ = xml.EscapeText(builder, []byte(attr.Value))
+			builder.WriteString("\"")
+		}
+		builder.WriteString(">")
+
+		for _, field := range element.Fields {
+			builder.WriteString("<" + field.Name + ">")
+			_ = xml.EscapeText(builder, []byte(field.Value))
+			builder.WriteString("</" + field.Name + ">")
+		}
+
+		builder.WriteString("</" + element.Name + ">")
+	}
+	builder.WriteString("</" + root + ">")
+
+	return builder.String()
+}
@@ -0,0 +1,120 @@
+package messages
+
+import (
+	"testing"
+)
+
+func TestSplitForMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		// Name and Key of every element
+		want []string
+	}{
+		{
+			name: "quotations by secid",
+			data: `<quotations><quotation secid="1"><last>1</last></quotation><quotation secid="2"><last>2</last></quotation></quotations>`,
+			want: []string{"quotation 1", "quotation 2"},
+		},
+		{
+			name: "quotations by board and seccode",
+			data: `<quotations><quotation><board>TQBR</board><seccode>SBER</seccode><last>1</last></quotation></quotations>`,
+			want: []string{"quotation TQBR:SBER"},
+		},
+		{
+			name: "quotes by price and source",
+			data: `<quotes><quote secid="1"><price>250</price><source>MICEX</source><buy>10</buy></quote>` +
+				`<quote secid="1"><price>250</price><buy>-1</buy></quote>` +
+				`<quote secid="1"><price>250</price><yield>7.5</yield><buy>5</buy></quote></quotes>`,
+			want: []string{"quote 1|250|MICEX|", "quote 1|250||", "quote 1|250||7.5"},
+		},
+		{name: "empty", data: `<quotations/>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			elements, err := SplitForMerge(test.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(elements) != len(test.want) {
+				t.Fatalf("got %d elements, want %d", len(elements), len(test.want))
+			}
+			for i, element := range elements {
+				if got := element.Name + " " + element.Key; got != test.want[i] {
+					t.Errorf("element %d is %q, want %q", i, got, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitForMergeInvalid(t *testing.T) {
+	for _, data := range []string{
+		`<quotations><quotation secid="1"><last><value>1</value></last></quotation></quotations>`,
+		`<quotations><quotation secid="1"><last>1</last></quotations>`,
+	} {
+		_, err := SplitForMerge(data)
+		if err == nil {
+			t.Errorf("SplitForMerge(%q) succeeded", data)
+		}
+	}
+}
+
+func TestMergeAndRender(t *testing.T) {
+	elements, err := SplitForMerge(`<quotations>` +
+		`<quotation secid="1"><last>250.10</last><bid>250.00</bid></quotation>` +
+		`<quotation secid="1" active="true"><last>250.20</last><voltoday>7</voltoday></quotation>` +
+		`</quotations>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the newer fields win, the new ones are appended
+	elements[0].Merge(elements[1])
+	got := RenderMerged(RootQuotations, elements[:1])
+	want := `<quotations><quotation secid="1" active="true"><last>250.20</last><bid>250.00</bid><voltoday>7</voltoday></quotation></quotations>`
+	if got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderMergedEscapes(t *testing.T) {
+	data := `<quotes><quote secid="&quot;1&lt;"><seccode>A&amp;B</seccode><price>1</price></quote></quotes>`
+	elements, err := SplitForMerge(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elements[0].Security != `"1<` {
+		t.Fatalf("security %q is not unescaped", elements[0].Security)
+	}
+
+	rendered := RenderMerged(RootQuotes, elements)
+	want := `<quotes><quote secid="&#34;1&lt;"><seccode>A&amp;B</seccode><price>1</price></quote></quotes>`
+	if rendered != want {
+		t.Fatalf("got\n%s\nwant\n%s", rendered, want)
+	}
+
+	// the rendered message is split the same way again
+	again, err := SplitForMerge(rendered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again[0].Key != elements[0].Key || again[0].Fields[0].Value != "A&B" {
+		t.Fatalf("got %+v after a round trip", again[0])
+	}
+}
+
+func TestMergeable(t *testing.T) {
+	for root, want := range map[string]bool{
+		RootQuotations: true,
+		RootQuotes:     true,
+		RootAllTrades:  false,
+		RootOrders:     false,
+	} {
+		if Mergeable(root) != want {
+			t.Errorf("Mergeable(%s) is %v", root, !want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package messages
+
+import "encoding/xml"
+
+type Orders struct {
+	XMLName    xml.Name    `xml:"orders"`
+	Items      []Order     `xml:"order"`
+	StopOrders []StopOrder `xml:"stoporder"`
+}
+
+type Order struct {
+	TransactionId  int64       `xml:"transactionid,attr"`
+	OrderNo        int64       `xml:"orderno"`
+	SecId          int         `xml:"secid"`
+	Board          string      `xml:"board"`
+	SecCode        string      `xml:"seccode"`
+	Client         string      `xml:"client"`
+	Union          string      `xml:"union"`
+	Status         OrderStatus `xml:"status"`
+	BuySell        BuySell     `xml:"buysell"`
+	Time           Time        `xml:"time"`
+	ExpDate        Time        `xml:"expdate"`
+	OriginOrderNo  int64       `xml:"origin_orderno"`
+	AcceptTime     Time        `xml:"accepttime"`
+	BrokerRef      string      `xml:"brokerref"`
+	Value          Decimal     `xml:"value"`
+	AccruedInt     Decimal     `xml:"accruedint"`
+	SettleCode     string      `xml:"settlecode"`
+	Balance        int64       `xml:"balance"`
+	Price          Decimal     `xml:"price"`
+	Quantity       int64       `xml:"quantity"`
+	Hidden         int64       `xml:"hidden"`
+	Yield          Decimal     `xml:"yield"`
+	WithdrawTime   Time        `xml:"withdrawtime"`
+	Condition      string      `xml:"condition"`
+	ConditionValue Decimal     `xml:"conditionvalue"`
+	ValidAfter     Time        `xml:"validafter"`
+	ValidBefore    Time        `xml:"validbefore"`
+	MaxComission   Decimal     `xml:"maxcomission"`
+	Result         string      `xml:"result"`
+}
+
+type StopOrder struct {
+	TransactionId int64       `xml:"transactionid,attr"`
+	ActiveOrderNo int64       `xml:"activeorderno"`
+	SecId         int         `xml:"secid"`
+	Board         string      `xml:"board"`
+	SecCode       string      `xml:"seccode"`
+	Client        string      `xml:"client"`
+	Union         string      `xml:"union"`
+	BuySell       BuySell     `xml:"buysell"`
+	Canceller     string      `xml:"canceller"`
+	AllTradeNo    int64       `xml:"alltradeno"`
+	ValidBefore   Time        `xml:"validbefore"`
+	Author        string      `xml:"author"`
+	AcceptTime    Time        `xml:"accepttime"`
+	LinkedOrderNo int64       `xml:"linkedorderno"`
+	ExpDate       Time        `xml:"expdate"`
+	Status        OrderStatus `xml:"status"`
+	StopLoss      *StopLoss   `xml:"stoploss"`
+	TakeProfit    *TakeProfit `xml:"takeprofit"`
+	Result        string      `xml:"result"`
+}
+
+type StopLoss struct {
+	UseCredit       Flag    `xml:"usecredit,attr"`
+	ActivationPrice Decimal `xml:"activationprice"`
+	GuardTime       Time    `xml:"guardtime"`
+	BrokerRef       string  `xml:"brokerref"`
+	Quantity        int64   `xml:"quantity"`
+	ByMarket        *string `xml:"bymarket"`
+	OrderPrice      Decimal `xml:"orderprice"`
+}
+
+type TakeProfit struct {
+	ActivationPrice Decimal `xml:"activationprice"`
+	GuardTime       Time    `xml:"guardtime"`
+	BrokerRef       string  `xml:"brokerref"`
+	Quantity        int64   `xml:"quantity"`
+	Extremum        Decimal `xml:"extremum"`
+	Level           Decimal `xml:"level"`
+	Correction      Decimal `xml:"correction"`
+}
+
+func (m *Orders) RootElement() string {
+	return RootOrders
+}
+
+type Trades struct {
+	XMLName xml.Name `xml:"trades"`
+	Items   []Trade  `xml:"trade"`
+}
+
+type Trade struct {
+	SecId      int     `xml:"secid"`
+	TradeNo    int64   `xml:"tradeno"`
+	OrderNo    int64   `xml:"orderno"`
+	Board      string  `xml:"board"`
+	SecCode    string  `xml:"seccode"`
+	Client     string  `xml:"client"`
+	Union      string  `xml:"union"`
+	BuySell    BuySell `xml:"buysell"`
+	Time       Time    `xml:"time"`
+	BrokerRef  string  `xml:"brokerref"`
+	Value      Decimal `xml:"value"`
+	Comission  Decimal `xml:"comission"`
+	Price      Decimal `xml:"price"`
+	Items      int64   `xml:"items"`
+	Quantity   int64   `xml:"quantity"`
+	Yield      Decimal `xml:"yield"`
+	CurrentPos int64   `xml:"currentpos"`
+	AccruedInt Decimal `xml:"accruedint"`
+	TradeType  string  `xml:"tradetype"`
+	SettleCode string  `xml:"settlecode"`
+}
+
+func (m *Trades) RootElement() string {
+	return RootTrades
+}
@@ -0,0 +1,201 @@
+package messages
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const (
+	RootServerStatus     = "server_status"
+	RootError            = "error"
+	RootMarkets          = "markets"
+	RootBoards           = "boards"
+	RootCandleKinds      = "candlekinds"
+	RootClient           = "client"
+	RootUnion            = "union"
+	RootOvernight        = "overnight"
+	RootConnectorVersion = "connector_version"
+	RootCurrentServer    = "current_server"
+	RootNewsHeader       = "news_header"
+	RootNewsBody         = "news_body"
+	RootMessages         = "messages"
+	RootSecurities       = "securities"
+	RootSecInfo          = "sec_info"
+	RootSecInfoUpd       = "sec_info_upd"
+	RootPits             = "pits"
+	RootQuotations       = "quotations"
+	RootAllTrades        = "alltrades"
+	RootQuotes           = "quotes"
+	RootTicks            = "ticks"
+	RootCandles          = "candles"
+	RootOrders           = "orders"
+	RootTrades           = "trades"
+	RootPositions        = "positions"
+	RootClientLimits     = "clientlimits"
+	RootMaxBuySell       = "max_buy_sell"
+)
+
+type Message interface {
+	RootElement() string
+}
+
+// Unknown is returned for root elements without a typed model.
+type Unknown struct {
+	Root string
+	Data string
+}
+
+func (m *Unknown) RootElement() string {
+	return m.Root
+}
+
+var factories = map[string]func() Message{
+	RootServerStatus:     func() Message { return &ServerStatus{} },
+	RootError:            func() Message { return &Error{} },
+	RootMarkets:          func() Message { return &Markets{} },
+	RootBoards:           func() Message { return &Boards{} },
+	RootCandleKinds:      func() Message { return &CandleKinds{} },
+	RootClient:           func() Message { return &Client{} },
+	RootUnion:            func() Message { return &Union{} },
+	RootOvernight:        func() Message { return &Overnight{} },
+	RootConnectorVersion: func() Message { return &ConnectorVersion{} },
+	RootCurrentServer:    func() Message { return &CurrentServer{} },
+	RootNewsHeader:       func() Message { return &NewsHeader{} },
+	RootNewsBody:         func() Message { return &NewsBody{} },
+	RootMessages:         func() Message { return &Messages{} },
+	RootSecurities:       func() Message { return &Securities{} },
+	RootSecInfo:          func() Message { return &SecInfo{} },
+	RootSecInfoUpd:       func() Message { return &SecInfoUpd{} },
+	RootPits:             func() Message { return &Pits{} },
+	RootQuotations:       func() Message { return &Quotations{} },
+	RootAllTrades:        func() Message { return &AllTrades{} },
+	RootQuotes:           func() Message { return &Quotes{} },
+	RootTicks:            func() Message { return &Ticks{} },
+	RootCandles:          func() Message { return &Candles{} },
+	RootOrders:           func() Message { return &Orders{} },
+	RootTrades:           func() Message { return &Trades{} },
+	RootPositions:        func() Message { return &Positions{} },
+	RootClientLimits:     func() Message { return &ClientLimits{} },
+	RootMaxBuySell:       func() Message { return &MaxBuySell{} },
+}
+
+// RootOf returns the name of the root element without decoding the document.
+func RootOf(data string) string {
+	for {
+		start := strings.IndexByte(data, '<')
+		if start < 0 || start+1 >= len(data) {
+			return ""
+		}
+
+		data = data[start+1:]
+		if data[0] == '?' || data[0] == '!' {
+			continue
+		}
+
+		end := strings.IndexAny(data, " \t\r\n/>")
+		if end < 0 {
+			return ""
+		}
+
+		return data[:end]
+	}
+}
+
+func Parse(data string) (Message, error) {
+	root := RootOf(data)
+
+	factory, ok := factories[root]
+	if !ok {
+		return &Unknown{Root: root, Data: data}, nil
+	}
+
+	msg := factory()
+	err := xml.Unmarshal([]byte(data), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+type ErrorHandler func(data string, err error)
+
+// Dispatcher routes callback messages to handlers registered for their root
+// element. Messages nobody listens to are not decoded at all.
+type Dispatcher struct {
+	mutex        *sync.RWMutex
+	handlers     map[string][]func(Message)
+	errorHandler ErrorHandler
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		mutex:        &sync.RWMutex{},
+		handlers:     map[string][]func(Message){},
+		errorHandler: func(string, error) {},
+	}
+}
+
+func (d *Dispatcher) Register(root string, handler func(Message)) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.handlers[root] = append(d.handlers[root], handler)
+}
+
+func (d *Dispatcher) OnError(handler ErrorHandler) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.errorHandler = handler
+}
+
+func (d *Dispatcher) Dispatch(data string) {
+	root := RootOf(data)
+
+	d.mutex.RLock()
+	handlers := d.handlers[root]
+	errorHandler := d.errorHandler
+	d.mutex.RUnlock()
+
+	if len(handlers) == 0 {
+		return
+	}
+
+	msg, err := Parse(data)
+	if err != nil {
+		errorHandler(data, err)
+		return
+	}
+
+	for _, handler := range handlers {
+		handler(msg)
+	}
+}
+
+// Handle registers a handler for the message type T, the root element is the one T is parsed from.
+func Handle[T Message](d *Dispatcher, handler func(T)) {
+	d.Register(rootOfType[T](), func(msg Message) {
+		typed, ok := msg.(T)
+		if ok {
+			handler(typed)
+		}
+	})
+}
+
+// rootOfType finds the root element of the model T among the factories, a
+// typed nil is never asked for it. Panics for types without a factory.
+func rootOfType[T Message]() string {
+	wanted := reflect.TypeOf((*T)(nil)).Elem()
+
+	for root, factory := range factories {
+		if reflect.TypeOf(factory()) == wanted {
+			return root
+		}
+	}
+
+	panic(fmt.Sprintf("messages: %s is not a callback message model", wanted))
+}
@@ -0,0 +1,228 @@
+package messages
+
+import (
+	"testing"
+	"time"
+)
+
+// samples of the TXmlConnector callback as the connector sends them
+const (
+	sampleServerStatus = `<server_status id="4" connected="true" recover="true" server_tz="Russian Standard Time"/>`
+	sampleServerError  = `<server_status connected="error">Неверный пароль</server_status>`
+	sampleSecurities   = `<securities><security secid="1" active="true"><seccode>SBER</seccode>` +
+		`<instrclass>E</instrclass><board>TQBR</board><market>1</market><currency>RUR</currency>` +
+		`<shortname>Сбербанк</shortname><decimals>2</decimals><minstep>0.01</minstep><lotsize>10</lotsize>` +
+		`<lotdivider>1</lotdivider><point_cost>1</point_cost>` +
+		`<opmask usecredit="yes" bymarket="yes" nosplit="no" fok="yes" ioc="yes"/>` +
+		`<sectype>SHARE</sectype><sec_tz>Russian Standard Time</sec_tz><quotestype>1</quotestype></security></securities>`
+	sampleQuotations = `<quotations><quotation secid="1"><board>TQBR</board><seccode>SBER</seccode>` +
+		`<last>271.35</last><quantity>20</quantity><time>18:39:54</time><bid>271.34</bid></quotation></quotations>`
+	sampleQuotes = `<quotes><quote secid="1"><board>TQBR</board><seccode>SBER</seccode><price>271.36</price>` +
+		`<source>MICEX</source><buy>-1</buy></quote></quotes>`
+	sampleOrders = `<orders><order transactionid="3507298"><orderno>29131526404</orderno><secid>1</secid>` +
+		`<board>TQBR</board><seccode>SBER</seccode><client>D0000001</client><union>U0001</union>` +
+		`<status>matched</status><buysell>B</buysell><time>21.02.2024 10:00:01.250</time>` +
+		`<brokerref></brokerref><value>2713.5</value><accruedint>0</accruedint><settlecode>Y2</settlecode>` +
+		`<balance>0</balance><price>271.35</price><quantity>1</quantity><hidden>0</hidden><yield>0</yield>` +
+		`<withdrawtime>0</withdrawtime><condition>None</condition><maxcomission>0</maxcomission>` +
+		`<result></result></order></orders>`
+	sampleTrades = `<trades><trade><secid>1</secid><tradeno>9484321</tradeno><orderno>29131526404</orderno>` +
+		`<board>TQBR</board><seccode>SBER</seccode><client>D0000001</client><union>U0001</union>` +
+		`<buysell>B</buysell><time>21.02.2024 10:00:01</time><value>2713.5</value><comission>0.54</comission>` +
+		`<price>271.35</price><items>1</items><quantity>10</quantity><currentpos>10</currentpos></trade></trades>`
+	sampleError = `<error>Не удалось разобрать команду</error>`
+)
+
+func TestParseSamples(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		check func(t *testing.T, msg Message)
+	}{
+		{
+			name: "server_status",
+			data: sampleServerStatus,
+			check: func(t *testing.T, msg Message) {
+				status := msg.(*ServerStatus)
+				if status.Id != 4 || status.Connected != ConnectionConnected || !status.Recover || status.ServerTz == "" {
+					t.Fatalf("got %+v", status)
+				}
+			},
+		},
+		{
+			name: "server_status error",
+			data: sampleServerError,
+			check: func(t *testing.T, msg Message) {
+				status := msg.(*ServerStatus)
+				if status.Connected != ConnectionError || status.Text != "Неверный пароль" {
+					t.Fatalf("got %+v", status)
+				}
+			},
+		},
+		{
+			name: "securities",
+			data: sampleSecurities,
+			check: func(t *testing.T, msg Message) {
+				security := msg.(*Securities).Items[0]
+				if security.SecId != 1 || !security.Active || security.SecCode != "SBER" || security.LotSize != 10 ||
+					security.MinStep.String() != "0.01" || !bool(security.OpMask.UseCredit) || bool(security.OpMask.NoSplit) {
+					t.Fatalf("got %+v", security)
+				}
+			},
+		},
+		{
+			name: "quotations",
+			data: sampleQuotations,
+			check: func(t *testing.T, msg Message) {
+				quotation := msg.(*Quotations).Items[0]
+				if quotation.Last == nil || quotation.Last.String() != "271.35" || *quotation.Quantity != 20 {
+					t.Fatalf("got %+v", quotation)
+				}
+				// missing fields stay nil
+				if quotation.Offer != nil || quotation.Open != nil {
+					t.Fatalf("missing fields are decoded: %+v", quotation)
+				}
+				if quotation.Time == nil || quotation.Time.Hour() != 18 || quotation.Time.Location() != MoscowLocation {
+					t.Fatalf("time %v", quotation.Time)
+				}
+			},
+		},
+		{
+			name: "quotes",
+			data: sampleQuotes,
+			check: func(t *testing.T, msg Message) {
+				quote := msg.(*Quotes).Items[0]
+				if quote.Buy == nil || *quote.Buy != -1 || quote.Sell != nil || quote.Source != "MICEX" {
+					t.Fatalf("got %+v", quote)
+				}
+			},
+		},
+		{
+			name: "orders",
+			data: sampleOrders,
+			check: func(t *testing.T, msg Message) {
+				order := msg.(*Orders).Items[0]
+				want := time.Date(2024, 2, 21, 10, 0, 1, 250*int(time.Millisecond), MoscowLocation)
+				if order.TransactionId != 3507298 || order.Status != OrderMatched || order.BuySell != Buy ||
+					!order.Time.Equal(want) || !order.WithdrawTime.IsZero() || order.Price.String() != "271.35" {
+					t.Fatalf("got %+v", order)
+				}
+			},
+		},
+		{
+			name: "trades",
+			data: sampleTrades,
+			check: func(t *testing.T, msg Message) {
+				trade := msg.(*Trades).Items[0]
+				if trade.TradeNo != 9484321 || trade.Quantity != 10 || trade.Comission.String() != "0.54" {
+					t.Fatalf("got %+v", trade)
+				}
+			},
+		},
+		{
+			name: "error",
+			data: sampleError,
+			check: func(t *testing.T, msg Message) {
+				if text := msg.(*Error).Text; text != "Не удалось разобрать команду" {
+					t.Fatalf("got %s", text)
+				}
+			},
+		},
+		{
+			name: "unknown root",
+			data: `<?xml version="1.0" encoding="utf-8"?><portfolio_tplus client="D0000001"/>`,
+			check: func(t *testing.T, msg Message) {
+				unknown := msg.(*Unknown)
+				if unknown.Root != "portfolio_tplus" || unknown.RootElement() != "portfolio_tplus" {
+					t.Fatalf("got %+v", unknown)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg, err := Parse(test.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.check(t, msg)
+		})
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "not closed", data: `<orders><order transactionid="1"><status>active</status></orders>`},
+		{name: "bad number", data: `<orders><order transactionid="first"/></orders>`},
+		{name: "bad decimal", data: `<quotations><quotation secid="1"><last>27,1</last></quotation></quotations>`},
+		{name: "bad time", data: `<trades><trade><time>yesterday</time></trade></trades>`},
+		{name: "bad flag", data: `<securities><security secid="1"><opmask usecredit="maybe"/></security></securities>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.data)
+			if err == nil {
+				t.Fatal("parsed")
+			}
+		})
+	}
+}
+
+func TestRootOf(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{data: sampleServerStatus, want: RootServerStatus},
+		{data: `<?xml version="1.0"?><!-- the header --><orders/>`, want: RootOrders},
+		{data: "\n<trades>\n</trades>", want: RootTrades},
+		{data: "no xml", want: ""},
+		{data: "<", want: ""},
+	}
+
+	for _, test := range tests {
+		if got := RootOf(test.data); got != test.want {
+			t.Errorf("RootOf(%q) = %q, want %q", test.data, got, test.want)
+		}
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	dispatcher := NewDispatcher()
+
+	var statuses []*ServerStatus
+	var failed []string
+	Handle(dispatcher, func(msg *ServerStatus) {
+		statuses = append(statuses, msg)
+	})
+	dispatcher.OnError(func(data string, err error) {
+		failed = append(failed, data)
+	})
+
+	dispatcher.Dispatch(sampleServerStatus)
+	// nobody listens, not decoded at all
+	dispatcher.Dispatch(`<orders><order transactionid="first"/></orders>`)
+	dispatcher.Dispatch(`<server_status id="x"/>`)
+
+	if len(statuses) != 1 || statuses[0].Id != 4 {
+		t.Fatalf("got %v", statuses)
+	}
+	if len(failed) != 1 || failed[0] != `<server_status id="x"/>` {
+		t.Fatalf("failed %v", failed)
+	}
+}
+
+func TestHandleWithoutModel(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("a handler of a type without a model is registered")
+		}
+	}()
+
+	Handle(NewDispatcher(), func(*Unknown) {})
+}
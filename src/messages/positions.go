@@ -0,0 +1,163 @@
+package messages
+
+import "encoding/xml"
+
+type Positions struct {
+	XMLName          xml.Name          `xml:"positions"`
+	Money            []MoneyPosition   `xml:"money_position"`
+	Securities       []SecPosition     `xml:"sec_position"`
+	Forts            []FortsPosition   `xml:"forts_position"`
+	FortsMoney       []FortsMoney      `xml:"forts_money"`
+	FortsCollaterals []FortsCollateral `xml:"forts_collaterals"`
+	SpotLimits       []SpotLimit       `xml:"spot_limit"`
+	UnitedLimits     []UnitedLimits    `xml:"united_limits"`
+}
+
+func (m *Positions) RootElement() string {
+	return RootPositions
+}
+
+type MoneyPosition struct {
+	Asset      string  `xml:"asset"`
+	Client     string  `xml:"client"`
+	Union      string  `xml:"union"`
+	Markets    []int   `xml:"markets>market"`
+	Register   string  `xml:"register"`
+	ShortName  string  `xml:"shortname"`
+	SaldoIn    Decimal `xml:"saldoin"`
+	Bought     Decimal `xml:"bought"`
+	Sold       Decimal `xml:"sold"`
+	Saldo      Decimal `xml:"saldo"`
+	OrdBuy     Decimal `xml:"ordbuy"`
+	OrdBuyCond Decimal `xml:"ordbuycond"`
+	Comission  Decimal `xml:"comission"`
+}
+
+type SecPosition struct {
+	SecId     int     `xml:"secid"`
+	Market    int     `xml:"market"`
+	SecCode   string  `xml:"seccode"`
+	Register  string  `xml:"register"`
+	Client    string  `xml:"client"`
+	Union     string  `xml:"union"`
+	ShortName string  `xml:"shortname"`
+	SaldoIn   int64   `xml:"saldoin"`
+	SaldoMin  int64   `xml:"saldomin"`
+	Bought    int64   `xml:"bought"`
+	Sold      int64   `xml:"sold"`
+	Saldo     int64   `xml:"saldo"`
+	OrdBuy    int64   `xml:"ordbuy"`
+	OrdSell   int64   `xml:"ordsell"`
+	Amount    Decimal `xml:"amount"`
+	Equity    Decimal `xml:"equity"`
+}
+
+type FortsPosition struct {
+	SecId             int     `xml:"secid"`
+	Markets           []int   `xml:"markets>market"`
+	SecCode           string  `xml:"seccode"`
+	Client            string  `xml:"client"`
+	Union             string  `xml:"union"`
+	StartNet          int64   `xml:"startnet"`
+	OpenBuys          int64   `xml:"openbuys"`
+	OpenSells         int64   `xml:"opensells"`
+	TotalNet          int64   `xml:"totalnet"`
+	TodayBuy          int64   `xml:"todaybuy"`
+	TodaySell         int64   `xml:"todaysell"`
+	OptMargin         Decimal `xml:"optmargin"`
+	VarMargin         Decimal `xml:"varmargin"`
+	ExpirationPos     int64   `xml:"expirationpos"`
+	UsedSellSpotLimit Decimal `xml:"usedsellspotlimit"`
+	SellSpotLimit     Decimal `xml:"sellspotlimit"`
+	Netto             Decimal `xml:"netto"`
+	Kgo               Decimal `xml:"kgo"`
+}
+
+type FortsMoney struct {
+	Client    string  `xml:"client"`
+	Union     string  `xml:"union"`
+	Markets   []int   `xml:"markets>market"`
+	ShortName string  `xml:"shortname"`
+	Current   Decimal `xml:"current"`
+	Blocked   Decimal `xml:"blocked"`
+	Free      Decimal `xml:"free"`
+	VarMargin Decimal `xml:"varmargin"`
+}
+
+type FortsCollateral struct {
+	Client    string  `xml:"client"`
+	Union     string  `xml:"union"`
+	Markets   []int   `xml:"markets>market"`
+	ShortName string  `xml:"shortname"`
+	Current   Decimal `xml:"current"`
+	Blocked   Decimal `xml:"blocked"`
+	Free      Decimal `xml:"free"`
+}
+
+type SpotLimit struct {
+	Client       string  `xml:"client"`
+	Union        string  `xml:"union"`
+	Markets      []int   `xml:"markets>market"`
+	ShortName    string  `xml:"shortname"`
+	BuyLimit     Decimal `xml:"buylimit"`
+	BuyLimitUsed Decimal `xml:"buylimitused"`
+}
+
+type UnitedLimits struct {
+	Union        string  `xml:"union"`
+	OpenEquity   Decimal `xml:"open_equity"`
+	Equity       Decimal `xml:"equity"`
+	Requirements Decimal `xml:"requirements"`
+	Free         Decimal `xml:"free"`
+	Vm           Decimal `xml:"vm"`
+	FinRes       Decimal `xml:"finres"`
+	Go           Decimal `xml:"go"`
+}
+
+type ClientLimits struct {
+	XMLName          xml.Name `xml:"clientlimits"`
+	Client           string   `xml:"client,attr"`
+	CbpLimit         Decimal  `xml:"cbplimit"`
+	CbpLUsed         Decimal  `xml:"cbplused"`
+	CbpLPlanned      Decimal  `xml:"cbplplanned"`
+	FobVarMargin     Decimal  `xml:"fob_varmargin"`
+	Coverage         Decimal  `xml:"coverage"`
+	LiquidityC       Decimal  `xml:"liquidity_c"`
+	Profit           Decimal  `xml:"profit"`
+	MoneyCurrent     Decimal  `xml:"money_current"`
+	MoneyBlocked     Decimal  `xml:"money_blocked"`
+	MoneyFree        Decimal  `xml:"money_free"`
+	OptionsPremium   Decimal  `xml:"options_premium"`
+	ExchangeFee      Decimal  `xml:"exchange_fee"`
+	FortsVarMargin   Decimal  `xml:"forts_varmargin"`
+	VarMargin        Decimal  `xml:"varmargin"`
+	PclMargin        Decimal  `xml:"pclmargin"`
+	OptionsVm        Decimal  `xml:"options_vm"`
+	SpotBuyLimit     Decimal  `xml:"spot_buy_limit"`
+	UsedStopBuyLimit Decimal  `xml:"used_stop_buy_limit"`
+	CollatCurrent    Decimal  `xml:"collat_current"`
+	CollatBlocked    Decimal  `xml:"collat_blocked"`
+	CollatFree       Decimal  `xml:"collat_free"`
+}
+
+func (m *ClientLimits) RootElement() string {
+	return RootClientLimits
+}
+
+type MaxBuySell struct {
+	XMLName xml.Name           `xml:"max_buy_sell"`
+	Client  string             `xml:"client,attr"`
+	Items   []MaxBuySellResult `xml:"security"`
+}
+
+type MaxBuySellResult struct {
+	SecId   int    `xml:"secid,attr"`
+	Market  int    `xml:"market"`
+	SecCode string `xml:"seccode"`
+	MaxBuy  int64  `xml:"maxbuy"`
+	MaxSell int64  `xml:"maxsell"`
+}
+
+func (m *MaxBuySell) RootElement() string {
+	return RootMaxBuySell
+}
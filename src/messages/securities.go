@@ -0,0 +1,114 @@
+package messages
+
+import "encoding/xml"
+
+type Securities struct {
+	XMLName xml.Name   `xml:"securities"`
+	Items   []Security `xml:"security"`
+}
+
+type Security struct {
+	SecId      int     `xml:"secid,attr"`
+	Active     bool    `xml:"active,attr"`
+	SecCode    string  `xml:"seccode"`
+	InstrClass string  `xml:"instrclass"`
+	Board      string  `xml:"board"`
+	Market     int     `xml:"market"`
+	Currency   string  `xml:"currency"`
+	ShortName  string  `xml:"shortname"`
+	Decimals   int     `xml:"decimals"`
+	MinStep    Decimal `xml:"minstep"`
+	LotSize    int64   `xml:"lotsize"`
+	LotDivider int64   `xml:"lotdivider"`
+	PointCost  Decimal `xml:"point_cost"`
+	OpMask     OpMask  `xml:"opmask"`
+	SecType    string  `xml:"sectype"`
+	SecTz      string  `xml:"sec_tz"`
+	QuotesType int     `xml:"quotestype"`
+	Mic        string  `xml:"MIC"`
+}
+
+type OpMask struct {
+	UseCredit Flag `xml:"usecredit,attr"`
+	ByMarket  Flag `xml:"bymarket,attr"`
+	NoSplit   Flag `xml:"nosplit,attr"`
+	Fok       Flag `xml:"fok,attr"`
+	Ioc       Flag `xml:"ioc,attr"`
+}
+
+func (m *Securities) RootElement() string {
+	return RootSecurities
+}
+
+type SecInfo struct {
+	XMLName       xml.Name `xml:"sec_info"`
+	SecId         int      `xml:"secid,attr"`
+	SecName       string   `xml:"secname"`
+	SecCode       string   `xml:"seccode"`
+	Market        int      `xml:"market"`
+	PName         string   `xml:"pname"`
+	MatDate       Time     `xml:"mat_date"`
+	ClearingPrice Decimal  `xml:"clearing_price"`
+	MinPrice      Decimal  `xml:"minprice"`
+	MaxPrice      Decimal  `xml:"maxprice"`
+	BuyDeposit    Decimal  `xml:"buy_deposit"`
+	SellDeposit   Decimal  `xml:"sell_deposit"`
+	BgoC          Decimal  `xml:"bgo_c"`
+	BgoNc         Decimal  `xml:"bgo_nc"`
+	AccruedInt    Decimal  `xml:"accruedint"`
+	CouponValue   Decimal  `xml:"coupon_value"`
+	CouponDate    Time     `xml:"coupon_date"`
+	CouponPeriod  int      `xml:"coupon_period"`
+	FaceValue     Decimal  `xml:"facevalue"`
+	PutCall       string   `xml:"put_call"`
+	PointCost     Decimal  `xml:"point_cost"`
+	OptType       string   `xml:"opt_type"`
+	LotVolume     int64    `xml:"lot_volume"`
+	Isin          string   `xml:"isin"`
+	RegNumber     string   `xml:"regnumber"`
+	BuybackPrice  Decimal  `xml:"buybackprice"`
+	BuybackDate   Time     `xml:"buybackdate"`
+	CurrencyId    string   `xml:"currencyid"`
+}
+
+func (m *SecInfo) RootElement() string {
+	return RootSecInfo
+}
+
+// SecInfoUpd carries only the fields that changed, absent values are nil.
+type SecInfoUpd struct {
+	XMLName   xml.Name `xml:"sec_info_upd"`
+	SecId     int      `xml:"secid"`
+	SecCode   string   `xml:"seccode"`
+	Market    int      `xml:"market"`
+	BgoC      *Decimal `xml:"bgo_c"`
+	BgoNc     *Decimal `xml:"bgo_nc"`
+	BgoBuy    *Decimal `xml:"bgo_buy"`
+	PointCost *Decimal `xml:"point_cost"`
+	MinPrice  *Decimal `xml:"min_price"`
+	MaxPrice  *Decimal `xml:"max_price"`
+}
+
+func (m *SecInfoUpd) RootElement() string {
+	return RootSecInfoUpd
+}
+
+type Pits struct {
+	XMLName xml.Name `xml:"pits"`
+	Items   []Pit    `xml:"pit"`
+}
+
+type Pit struct {
+	SecCode    string  `xml:"seccode,attr"`
+	Board      string  `xml:"board,attr"`
+	Market     int     `xml:"market"`
+	Decimals   int     `xml:"decimals"`
+	MinStep    Decimal `xml:"minstep"`
+	LotSize    int64   `xml:"lotsize"`
+	LotDivider int64   `xml:"lotdivider"`
+	PointCost  Decimal `xml:"point_cost"`
+}
+
+func (m *Pits) RootElement() string {
+	return RootPits
+}
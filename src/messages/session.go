@@ -0,0 +1,160 @@
+package messages
+
+import "encoding/xml"
+
+type ServerStatus struct {
+	XMLName   xml.Name        `xml:"server_status"`
+	Id        int             `xml:"id,attr"`
+	Connected ConnectionState `xml:"connected,attr"`
+	Recover   bool            `xml:"recover,attr"`
+	ServerTz  string          `xml:"server_tz,attr"`
+	Text      string          `xml:",chardata"`
+}
+
+func (m *ServerStatus) RootElement() string {
+	return RootServerStatus
+}
+
+type Error struct {
+	XMLName xml.Name `xml:"error"`
+	Text    string   `xml:",chardata"`
+}
+
+func (m *Error) RootElement() string {
+	return RootError
+}
+
+type Markets struct {
+	XMLName xml.Name `xml:"markets"`
+	Items   []Market `xml:"market"`
+}
+
+type Market struct {
+	Id   int    `xml:"id,attr"`
+	Name string `xml:",chardata"`
+}
+
+func (m *Markets) RootElement() string {
+	return RootMarkets
+}
+
+type Boards struct {
+	XMLName xml.Name `xml:"boards"`
+	Items   []Board  `xml:"board"`
+}
+
+type Board struct {
+	Id     string `xml:"id,attr"`
+	Name   string `xml:"name"`
+	Market int    `xml:"market"`
+	Type   int    `xml:"type"`
+}
+
+func (m *Boards) RootElement() string {
+	return RootBoards
+}
+
+type CandleKinds struct {
+	XMLName xml.Name     `xml:"candlekinds"`
+	Items   []CandleKind `xml:"kind"`
+}
+
+type CandleKind struct {
+	Id     int    `xml:"id"`
+	Period int    `xml:"period"`
+	Name   string `xml:"name"`
+}
+
+func (m *CandleKinds) RootElement() string {
+	return RootCandleKinds
+}
+
+type Client struct {
+	XMLName  xml.Name `xml:"client"`
+	Id       string   `xml:"id,attr"`
+	Remove   bool     `xml:"remove,attr"`
+	Type     string   `xml:"type"`
+	Currency string   `xml:"currency"`
+	Markets  []int    `xml:"market"`
+	Union    string   `xml:"union"`
+	FortsAcc string   `xml:"forts_acc"`
+}
+
+func (m *Client) RootElement() string {
+	return RootClient
+}
+
+type Union struct {
+	XMLName xml.Name `xml:"union"`
+	Id      string   `xml:"id,attr"`
+	Remove  bool     `xml:"remove,attr"`
+}
+
+func (m *Union) RootElement() string {
+	return RootUnion
+}
+
+type Overnight struct {
+	XMLName xml.Name `xml:"overnight"`
+	Status  bool     `xml:"status,attr"`
+}
+
+func (m *Overnight) RootElement() string {
+	return RootOvernight
+}
+
+type ConnectorVersion struct {
+	XMLName xml.Name `xml:"connector_version"`
+	Version string   `xml:",chardata"`
+}
+
+func (m *ConnectorVersion) RootElement() string {
+	return RootConnectorVersion
+}
+
+type CurrentServer struct {
+	XMLName xml.Name `xml:"current_server"`
+	Id      int      `xml:"id,attr"`
+}
+
+func (m *CurrentServer) RootElement() string {
+	return RootCurrentServer
+}
+
+type NewsHeader struct {
+	XMLName   xml.Name `xml:"news_header"`
+	Id        int64    `xml:"id"`
+	Timestamp Time     `xml:"timestamp"`
+	Source    string   `xml:"source"`
+	Title     string   `xml:"title"`
+}
+
+func (m *NewsHeader) RootElement() string {
+	return RootNewsHeader
+}
+
+type NewsBody struct {
+	XMLName xml.Name `xml:"news_body"`
+	Id      int64    `xml:"id"`
+	Text    string   `xml:"text"`
+}
+
+func (m *NewsBody) RootElement() string {
+	return RootNewsBody
+}
+
+type Messages struct {
+	XMLName xml.Name      `xml:"messages"`
+	Items   []TextMessage `xml:"message"`
+}
+
+type TextMessage struct {
+	From   string `xml:"from"`
+	Date   Time   `xml:"date"`
+	Urgent Flag   `xml:"urgent"`
+	Text   string `xml:"text"`
+}
+
+func (m *Messages) RootElement() string {
+	return RootMessages
+}
@@ -0,0 +1,169 @@
+package messages
+
+import (
+	"bytes"
+	"errors"
+	"github.com/shopspring/decimal"
+	"strings"
+	"time"
+)
+
+// MoscowLocation is the time zone of all Transaq timestamps. Moscow has no DST,
+// so a fixed zone works even where tzdata is missing (wine).
+var MoscowLocation = time.FixedZone("MSK", 3*60*60)
+
+var timeLayouts = []string{
+	"02.01.2006 15:04:05.000",
+	"02.01.2006 15:04:05",
+	"02.01.2006",
+}
+
+const timeOnlyLayout = "15:04:05"
+
+// Decimal keeps prices exact, empty elements are decoded as zero.
+type Decimal struct {
+	decimal.Decimal
+}
+
+func NewDecimal(value string) (Decimal, error) {
+	d := Decimal{}
+	err := d.UnmarshalText([]byte(value))
+
+	return d, err
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	text = bytes.TrimSpace(text)
+	if len(text) == 0 {
+		d.Decimal = decimal.Zero
+		return nil
+	}
+
+	value, err := decimal.NewFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	d.Decimal = value
+
+	return nil
+}
+
+// Time is a Transaq timestamp in Moscow time. Values that carry only a time
+// of day (quotations) are placed on the current Moscow date.
+type Time struct {
+	time.Time
+}
+
+func ParseTime(value string) (Time, error) {
+	t := Time{}
+	err := t.UnmarshalText([]byte(value))
+
+	return t, err
+}
+
+func (t *Time) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+	if value == "" || value == "0" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range timeLayouts {
+		parsed, err := time.ParseInLocation(layout, value, MoscowLocation)
+		if err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	parsed, err := time.ParseInLocation(timeOnlyLayout, value, MoscowLocation)
+	if err != nil {
+		return errors.New("unknown time format: " + value)
+	}
+
+	t.Time = onMoscowDate(parsed, time.Now())
+
+	return nil
+}
+
+// onMoscowDate moves a time of day to the Moscow date of now, which is a day
+// ahead of UTC from 21:00 UTC.
+func onMoscowDate(clock time.Time, now time.Time) time.Time {
+	date := now.In(MoscowLocation)
+
+	return time.Date(
+		date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, MoscowLocation,
+	)
+}
+
+// Flag decodes the yes/no, Y/N and true/false variants used by the connector.
+type Flag bool
+
+func (f *Flag) UnmarshalText(text []byte) error {
+	switch strings.ToLower(strings.TrimSpace(string(text))) {
+	case "yes", "y", "true", "1":
+		*f = true
+	case "no", "n", "false", "0", "":
+		*f = false
+	default:
+		return errors.New("unknown flag value: " + string(text))
+	}
+
+	return nil
+}
+
+type BuySell string
+
+const (
+	Buy  BuySell = "B"
+	Sell BuySell = "S"
+)
+
+type ConnectionState string
+
+const (
+	ConnectionConnected    ConnectionState = "true"
+	ConnectionDisconnected ConnectionState = "false"
+	ConnectionError        ConnectionState = "error"
+)
+
+type OrderStatus string
+
+const (
+	OrderActive                OrderStatus = "active"
+	OrderCancelled             OrderStatus = "cancelled"
+	OrderDenied                OrderStatus = "denied"
+	OrderDisabled              OrderStatus = "disabled"
+	OrderExpired               OrderStatus = "expired"
+	OrderFailed                OrderStatus = "failed"
+	OrderForwarding            OrderStatus = "forwarding"
+	OrderInactive              OrderStatus = "inactive"
+	OrderMatched               OrderStatus = "matched"
+	OrderRefused               OrderStatus = "refused"
+	OrderRejected              OrderStatus = "rejected"
+	OrderRemoved               OrderStatus = "removed"
+	OrderWait                  OrderStatus = "wait"
+	OrderWatching              OrderStatus = "watching"
+	OrderSLExecuted            OrderStatus = "sl_executed"
+	OrderSLForwarding          OrderStatus = "sl_forwarding"
+	OrderSLGuardTime           OrderStatus = "sl_guardtime"
+	OrderTPCorrection          OrderStatus = "tp_correction"
+	OrderTPCorrectionGuardTime OrderStatus = "tp_correction_guardtime"
+	OrderTPExecuted            OrderStatus = "tp_executed"
+	OrderTPForwarding          OrderStatus = "tp_forwarding"
+	OrderTPGuardTime           OrderStatus = "tp_guardtime"
+	OrderLinkWait              OrderStatus = "linkwait"
+)
+
+// SecurityStatus is the tradability flag of a security reported in quotations.
+type SecurityStatus string
+
+const (
+	SecurityActive    SecurityStatus = "A"
+	SecuritySuspended SecurityStatus = "S"
+	SecurityNotTraded SecurityStatus = "N"
+)
+
+// TradingStatus is the exchange session state code, passed through as is.
+type TradingStatus string
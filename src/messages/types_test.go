@@ -0,0 +1,90 @@
+package messages
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{value: "21.02.2024 10:00:01.250", want: time.Date(2024, 2, 21, 10, 0, 1, 250000000, MoscowLocation)},
+		{value: "21.02.2024 10:00:01", want: time.Date(2024, 2, 21, 10, 0, 1, 0, MoscowLocation)},
+		{value: "21.02.2024", want: time.Date(2024, 2, 21, 0, 0, 0, 0, MoscowLocation)},
+		{value: " 0 ", want: time.Time{}},
+		{value: "", want: time.Time{}},
+	}
+
+	for _, test := range tests {
+		got, err := ParseTime(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("ParseTime(%q) = %v, want %v", test.value, got.Time, test.want)
+		}
+	}
+
+	_, err := ParseTime("10 o'clock")
+	if err == nil {
+		t.Fatal("an unknown format is parsed")
+	}
+}
+
+func TestOnMoscowDate(t *testing.T) {
+	clock := time.Date(0, 1, 1, 18, 39, 54, 0, MoscowLocation)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "same date",
+			now:  time.Date(2024, 2, 21, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 2, 21, 18, 39, 54, 0, MoscowLocation),
+		},
+		{
+			name: "moscow is a day ahead of utc",
+			now:  time.Date(2024, 2, 21, 22, 30, 0, 0, time.UTC),
+			want: time.Date(2024, 2, 22, 18, 39, 54, 0, MoscowLocation),
+		},
+		{
+			name: "new year in moscow",
+			now:  time.Date(2023, 12, 31, 21, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 1, 18, 39, 54, 0, MoscowLocation),
+		},
+		{
+			name: "a zone behind utc",
+			now:  time.Date(2024, 2, 21, 20, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+			want: time.Date(2024, 2, 22, 18, 39, 54, 0, MoscowLocation),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := onMoscowDate(clock, test.now)
+			if !got.Equal(test.want) || got.Location() != MoscowLocation {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTimeOnlyIsToday(t *testing.T) {
+	got, err := ParseTime("18:39:54")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().In(MoscowLocation)
+	if got.Hour() != 18 || got.Minute() != 39 || got.Second() != 54 {
+		t.Fatalf("got %v", got.Time)
+	}
+	// a midnight in between moves the date
+	if got.YearDay() != now.YearDay() && got.YearDay() != now.Add(-time.Minute).YearDay() {
+		t.Fatalf("got %v on %v", got.Time, now)
+	}
+}
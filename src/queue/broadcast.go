@@ -0,0 +1,83 @@
+package queue
+
+import (
+	"context"
+	"sync"
+)
+
+// Broadcast hands the latest value to every watcher. A watcher that does not
+// keep up loses intermediate values but always receives the newest one.
+type Broadcast[T interface{}] struct {
+	mutex    *sync.Mutex
+	watchers map[chan T]struct{}
+	size     int
+	last     T
+	hasLast  bool
+}
+
+func NewBroadcast[T interface{}](size int) *Broadcast[T] {
+	return &Broadcast[T]{
+		mutex:    &sync.Mutex{},
+		watchers: map[chan T]struct{}{},
+		size:     size,
+	}
+}
+
+func (b *Broadcast[T]) Publish(value T) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.last = value
+	b.hasLast = true
+
+	for ch := range b.watchers {
+		offer(ch, value)
+	}
+}
+
+func (b *Broadcast[T]) Last() (T, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.last, b.hasLast
+}
+
+// Watch starts with the last published value, the channel is closed when ctx is done.
+func (b *Broadcast[T]) Watch(ctx context.Context) <-chan T {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	ch := make(chan T, b.size)
+	if b.hasLast {
+		ch <- b.last
+	}
+	b.watchers[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		delete(b.watchers, ch)
+		close(ch)
+	}()
+
+	return ch
+}
+
+func offer[T interface{}](ch chan T, value T) {
+	for {
+		select {
+		case ch <- value:
+			return
+		default:
+		}
+
+		// drop the oldest value to make room for the newest one
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
@@ -1,165 +1,382 @@
 package queue
 
 import (
-	"container/list"
 	"context"
+	"errors"
+	"math"
 	"sync"
 	"time"
 )
 
-type FixedQueue[T interface{}] struct {
-	maxSize      int
-	elementsList *list.List
-	mutex        *sync.Mutex
-	channelsBag  *list.List
-}
+// Policy tells what happens to a subscriber that falls behind by more than its MaxLag.
+type Policy int
+
+const (
+	// DropOldest skips the oldest pending elements, the subscriber stays current
+	DropOldest Policy = iota
+	// DropNewest keeps up to MaxLag pending elements of its own, so the ring can not
+	// overwrite them, and skips the new ones until there is room
+	DropNewest
+	// Block lets the subscriber fall behind by up to the queue size for BlockTimeout
+	// once it is over MaxLag, Push never waits for it. Then it loses the oldest
+	// elements down to MaxLag
+	Block
+	// Disconnect closes the subscription with ErrSlowConsumer
+	Disconnect
+)
 
-type channelBag[T interface{}] struct {
-	ch  chan T
-	ctx context.Context
+var ErrSlowConsumer = errors.New("subscriber is too slow")
+
+type SubscribeOptions struct {
+	Policy Policy
+	// 0 or anything above the queue size means the queue size
+	MaxLag       int
+	BlockTimeout time.Duration
+	// start with the next push, the elements nobody received yet are skipped
+	Tail bool
 }
 
-func NewFixedQueue[T interface{}](ctx context.Context, size int) *FixedQueue[T] {
-	l := list.New()
-	l.Init()
+// FixedQueue keeps the last maxSize elements in a ring and broadcasts them to
+// every subscriber. Each subscriber has its own cursor, so it receives every
+// element in push order unless it falls behind by more than its MaxLag. Then
+// its Policy decides which elements it loses, the lost count is reported with
+// the next item.
+type FixedQueue[T interface{}] struct {
+	maxSize  int
+	ring     []T
+	mutex    *sync.Mutex
+	sequence uint64
+	// the first element that no subscriber has received yet, elements pushed
+	// while nobody listens are handed to the next subscriber
+	undelivered uint64
+	subscribers map[*Subscription[T]]struct{}
+	// closed and replaced on every push to wake up waiting subscribers
+	notify chan struct{}
+	// the ring grows instead of overwriting elements not received yet
+	lossless bool
+}
 
-	channelsBagList := list.New()
-	channelsBagList.Init()
+// Item is an element with its position in the queue. Missed is the number of
+// elements the subscriber lost right before this one.
+type Item[T interface{}] struct {
+	Sequence uint64
+	Value    T
+	Missed   uint64
+	// set by LanedQueue subscriptions
+	Lane Lane
+}
 
-	m := sync.Mutex{}
+type Subscription[T interface{}] struct {
+	queue   *FixedQueue[T]
+	options SubscribeOptions
+	cursor  uint64
+	// DropNewest subscriber keeps its pending elements, the ring may overwrite them
+	retained []Item[T]
+	// dropped by DropNewest since the last retained element
+	dropped uint64
+	// missed and not reported yet
+	missed uint64
+	// missed since the subscription start
+	missedTotal uint64
+	// Block subscriber has been over MaxLag since then
+	overSince time.Time
+	err       error
+}
 
-	obj := FixedQueue[T]{
-		maxSize:      size,
-		elementsList: l,
-		mutex:        &m,
-		channelsBag:  channelsBagList,
+func NewFixedQueue[T interface{}](size int) *FixedQueue[T] {
+	return &FixedQueue[T]{
+		maxSize:     size,
+		ring:        make([]T, size),
+		mutex:       &sync.Mutex{},
+		undelivered: 1,
+		subscribers: map[*Subscription[T]]struct{}{},
+		notify:      make(chan struct{}),
 	}
+}
 
-	go obj.dispatchToChannels(ctx)
+// NewLosslessQueue starts with a ring of size and doubles it whenever a push
+// would overwrite an element that a subscriber, or the next subscriber while
+// nobody listens, has not received yet. Subscribe options are ignored.
+func NewLosslessQueue[T interface{}](size int) *FixedQueue[T] {
+	q := NewFixedQueue[T](size)
+	q.lossless = true
 
-	return &obj
+	return q
 }
 
-func (q *FixedQueue[T]) Push(element T) {
+func (q *FixedQueue[T]) Push(element T) uint64 {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
-	actualSize := q.elementsList.Len()
+	if q.lossless {
+		q.reserve()
+	}
+
+	q.sequence++
+	q.ring[q.sequence%uint64(q.maxSize)] = element
+
+	for subscription := range q.subscribers {
+		if subscription.options.Policy == DropNewest {
+			subscription.retain(q.sequence, element)
+			continue
+		}
+		if subscription.pending() <= uint64(subscription.options.MaxLag) {
+			continue
+		}
+
+		switch subscription.options.Policy {
+		case Block:
+			if subscription.overSince.IsZero() {
+				subscription.overSince = time.Now()
+			}
+		case Disconnect:
+			subscription.err = ErrSlowConsumer
+			delete(q.subscribers, subscription)
+		}
+	}
+
+	close(q.notify)
+	q.notify = make(chan struct{})
 
-	if actualSize >= q.maxSize {
-		diff := actualSize - q.maxSize + 1
-		for i := 0; i < diff; i++ {
-			_ = q.catchHead()
+	return q.sequence
+}
+
+// reserve grows the ring of a lossless queue when the next element would
+// overwrite a pending one, must be called with the mutex held.
+func (q *FixedQueue[T]) reserve() {
+	floor := q.undelivered
+	for subscription := range q.subscribers {
+		if subscription.cursor < floor {
+			floor = subscription.cursor
 		}
 	}
+	if q.sequence+1-floor < uint64(q.maxSize) {
+		return
+	}
 
-	q.elementsList.PushBack(element)
+	ring := make([]T, q.maxSize*2)
+	for sequence := floor; sequence <= q.sequence; sequence++ {
+		ring[sequence%uint64(len(ring))] = q.ring[sequence%uint64(q.maxSize)]
+	}
+	q.ring = ring
+	q.maxSize = len(ring)
+}
+
+// oldest retained sequence, must be called with the mutex held.
+func (q *FixedQueue[T]) oldest() uint64 {
+	if q.sequence < uint64(q.maxSize) {
+		return 1
+	}
+
+	return q.sequence - uint64(q.maxSize) + 1
 }
 
-func (q *FixedQueue[T]) Pop() *T {
+// Subscribe starts with the elements nobody has received yet. The subscription
+// must be closed when it is not needed anymore.
+func (q *FixedQueue[T]) Subscribe(options SubscribeOptions) *Subscription[T] {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
-	element := q.catchHead()
+	if options.MaxLag <= 0 || options.MaxLag > q.maxSize {
+		options.MaxLag = q.maxSize
+	}
+	if q.lossless {
+		options = SubscribeOptions{Policy: DropOldest, MaxLag: math.MaxInt, Tail: options.Tail}
+	}
+
+	cursor := q.undelivered
+	if oldest := q.oldest(); cursor < oldest {
+		cursor = oldest
+	}
+	if options.Tail {
+		cursor = q.sequence + 1
+	}
+	if q.sequence+1-cursor > uint64(options.MaxLag) {
+		cursor = q.sequence + 1 - uint64(options.MaxLag)
+	}
+
+	subscription := &Subscription[T]{
+		queue:   q,
+		options: options,
+		cursor:  cursor,
+	}
+	if options.Policy == DropNewest {
+		for sequence := cursor; sequence <= q.sequence; sequence++ {
+			subscription.retain(sequence, q.ring[sequence%uint64(q.maxSize)])
+		}
+	}
+	q.subscribers[subscription] = struct{}{}
 
-	return element
+	return subscription
+}
+
+func (q *FixedQueue[T]) GetMaxSize() int {
+	return q.maxSize
 }
 
-func (q *FixedQueue[T]) catchHead() *T {
-	head := q.elementsList.Front()
+// Next returns the element under the cursor, waiting for a push if the
+// subscriber has caught up.
+func (s *Subscription[T]) Next(ctx context.Context) (Item[T], error) {
+	for {
+		item, ok, notify, err := s.tryNext()
+		if err != nil || ok {
+			return item, err
+		}
 
-	if head == nil {
-		return nil
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return Item[T]{}, ctx.Err()
+		}
 	}
+}
+
+// tryNext does not wait, when there is nothing to take the returned channel
+// is closed by the next push.
+func (s *Subscription[T]) tryNext() (Item[T], bool, <-chan struct{}, error) {
+	q := s.queue
 
-	q.elementsList.Remove(head)
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
 
-	element, ok := head.Value.(T)
+	if s.err != nil {
+		return Item[T]{}, false, nil, s.err
+	}
 
+	item, ok := s.take()
 	if !ok {
-		return nil
+		return item, false, q.notify, nil
 	}
 
-	return &element
+	return item, true, nil, nil
 }
 
-func (q *FixedQueue[T]) removeBagAndGoNext(element *list.Element, value *channelBag[T]) *list.Element {
-	if value != nil {
-		close(value.ch)
+func (s *Subscription[T]) Close() {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	delete(s.queue.subscribers, s)
+	if s.err == nil {
+		s.err = context.Canceled
 	}
 
-	nextElement := element.Next()
-	q.channelsBag.Remove(element)
-	return nextElement
+	// a Next may wait for a push
+	close(s.queue.notify)
+	s.queue.notify = make(chan struct{})
 }
 
-func (q *FixedQueue[T]) dispatchToChannels(ctx context.Context) {
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
+// pending is the number of elements waiting for the subscriber, must be called
+// with the queue mutex held.
+func (s *Subscription[T]) pending() uint64 {
+	if s.options.Policy == DropNewest {
+		return uint64(len(s.retained))
+	}
 
-		if q.channelsBag.Len() == 0 {
-			time.Sleep(time.Second * 1)
-			continue
-		}
+	return s.queue.sequence + 1 - s.cursor
+}
+
+// retain keeps the element for a DropNewest subscriber that has room for it,
+// the dropped ones are reported with the next element kept.
+func (s *Subscription[T]) retain(sequence uint64, element T) {
+	if len(s.retained) >= s.options.MaxLag {
+		s.dropped++
+		s.missedTotal++
+		return
+	}
 
-		head := q.Pop()
+	s.retained = append(s.retained, Item[T]{Sequence: sequence, Value: element, Missed: s.dropped})
+	s.dropped = 0
+}
 
-		if head == nil {
-			time.Sleep(time.Millisecond * 10)
-			continue
+// take must be called with the queue mutex held.
+func (s *Subscription[T]) take() (Item[T], bool) {
+	q := s.queue
+
+	if s.options.Policy == DropNewest {
+		return s.takeRetained()
+	}
+
+	oldest := q.oldest()
+	if s.limited() && q.sequence >= uint64(s.options.MaxLag) {
+		if limit := q.sequence - uint64(s.options.MaxLag) + 1; limit > oldest {
+			oldest = limit
 		}
+	}
+	if s.cursor < oldest {
+		// the subscriber is too slow, the elements are dropped or overwritten already
+		s.lose(oldest - s.cursor)
+		s.cursor = oldest
+	}
 
-		element := q.channelsBag.Front()
-		hasReceivers := false
-		for element != nil {
-			bag, ok := element.Value.(channelBag[T])
+	if s.cursor > q.sequence {
+		return Item[T]{}, false
+	}
 
-			if !ok {
-				element = q.removeBagAndGoNext(element, nil)
-				continue
-			}
+	item := Item[T]{
+		Sequence: s.cursor,
+		Value:    q.ring[s.cursor%uint64(q.maxSize)],
+		Missed:   s.missed,
+	}
 
-			select {
-			case <-bag.ctx.Done():
-				element = q.removeBagAndGoNext(element, &bag)
-				continue
-			default:
-			}
+	s.missed = 0
+	s.advance(s.cursor + 1)
+	if s.pending() <= uint64(s.options.MaxLag) {
+		s.overSince = time.Time{}
+	}
 
-			// если не смогли записать в канал, пропускаем его
-			select {
-			case bag.ch <- *head:
-				hasReceivers = true
-			default:
-			}
+	return item, true
+}
 
-			element = element.Next()
-		}
+// limited tells whether the subscriber loses the elements over MaxLag, a Block
+// subscriber does after BlockTimeout only. Must be called with the queue mutex held.
+func (s *Subscription[T]) limited() bool {
+	if s.options.Policy != Block {
+		return true
+	}
 
-		if !hasReceivers {
-			// message not delivered, push it again
-			q.Push(*head)
-		}
+	return !s.overSince.IsZero() && time.Since(s.overSince) > s.options.BlockTimeout
+}
+
+// takeRetained must be called with the queue mutex held.
+func (s *Subscription[T]) takeRetained() (Item[T], bool) {
+	if len(s.retained) == 0 {
+		return Item[T]{}, false
 	}
+
+	item := s.retained[0]
+	var empty Item[T]
+	s.retained[0] = empty
+	s.retained = s.retained[1:]
+
+	s.advance(item.Sequence + 1)
+
+	return item, true
 }
 
-func (q *FixedQueue[T]) Fetch(ctx context.Context) <-chan T {
-	q.mutex.Lock()
-	defer q.mutex.Unlock()
+// advance moves the cursor past a taken element, must be called with the queue mutex held.
+func (s *Subscription[T]) advance(cursor uint64) {
+	s.cursor = cursor
+	if cursor > s.queue.undelivered {
+		s.queue.undelivered = cursor
+	}
+}
 
-	elementsChannel := make(chan T, q.maxSize)
-	q.channelsBag.PushBack(channelBag[T]{
-		ch:  elementsChannel,
-		ctx: ctx,
-	})
+func (s *Subscription[T]) lose(count uint64) {
+	s.missed += count
+	s.missedTotal += count
+}
+
+// Lag is the number of pushed elements not handed to the subscriber yet.
+func (s *Subscription[T]) Lag() uint64 {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
 
-	return elementsChannel
+	return s.pending()
 }
 
-func (q *FixedQueue[T]) GetMaxSize() int {
-	return q.maxSize
+func (s *Subscription[T]) Missed() uint64 {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return s.missedTotal
 }
@@ -0,0 +1,388 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+const throughputSubscribers = 4
+
+func nextValue(t *testing.T, subscription *Subscription[int]) Item[int] {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	item, err := subscription.Next(ctx)
+	if err != nil {
+		t.Fatalf("next failed: %s", err)
+	}
+
+	return item
+}
+
+func expectEmpty(t *testing.T, subscription *Subscription[int]) {
+	t.Helper()
+
+	item, ok, _, err := subscription.tryNext()
+	if err != nil || ok {
+		t.Fatalf("nothing expected, got %+v %v", item, err)
+	}
+}
+
+func TestFixedQueueCursors(t *testing.T) {
+	q := NewFixedQueue[int](8)
+
+	// pushed while nobody listens, handed to the first subscriber
+	q.Push(1)
+	first := q.Subscribe(SubscribeOptions{})
+	defer first.Close()
+	second := q.Subscribe(SubscribeOptions{})
+	defer second.Close()
+	q.Push(2)
+	q.Push(3)
+
+	for _, want := range []int{1, 2, 3} {
+		item := nextValue(t, first)
+		if item.Value != want || item.Sequence != uint64(want) || item.Missed != 0 {
+			t.Fatalf("first got %+v, want %d", item, want)
+		}
+	}
+	expectEmpty(t, first)
+
+	// nobody had taken 1 when the second subscriber came, its own cursor starts there
+	for _, want := range []int{1, 2, 3} {
+		item := nextValue(t, second)
+		if item.Value != want {
+			t.Fatalf("second got %+v, want %d", item, want)
+		}
+	}
+	expectEmpty(t, second)
+
+	// everything was delivered, a late subscriber starts with new elements
+	late := q.Subscribe(SubscribeOptions{})
+	defer late.Close()
+	expectEmpty(t, late)
+	q.Push(4)
+	for _, subscription := range []*Subscription[int]{first, second, late} {
+		if item := nextValue(t, subscription); item.Value != 4 {
+			t.Fatalf("got %+v, want 4", item)
+		}
+	}
+}
+
+func TestFixedQueueTail(t *testing.T) {
+	q := NewFixedQueue[int](8)
+
+	// nobody received them, a tail subscriber skips them anyway
+	q.Push(1)
+	q.Push(2)
+	tail := q.Subscribe(SubscribeOptions{Tail: true})
+	defer tail.Close()
+	expectEmpty(t, tail)
+
+	q.Push(3)
+	if item := nextValue(t, tail); item.Value != 3 || item.Missed != 0 {
+		t.Fatalf("got %+v, want 3", item)
+	}
+	expectEmpty(t, tail)
+}
+
+func TestFixedQueueDropOldest(t *testing.T) {
+	q := NewFixedQueue[int](8)
+	subscription := q.Subscribe(SubscribeOptions{Policy: DropOldest, MaxLag: 4})
+	defer subscription.Close()
+
+	for i := 1; i <= 10; i++ {
+		q.Push(i)
+	}
+
+	item := nextValue(t, subscription)
+	if item.Value != 7 || item.Missed != 6 {
+		t.Fatalf("got %+v, want 7 after 6 missed", item)
+	}
+	for _, want := range []int{8, 9, 10} {
+		if item := nextValue(t, subscription); item.Value != want || item.Missed != 0 {
+			t.Fatalf("got %+v, want %d", item, want)
+		}
+	}
+	if missed := subscription.Missed(); missed != 6 {
+		t.Fatalf("missed %d, want 6", missed)
+	}
+}
+
+func TestFixedQueueDropNewest(t *testing.T) {
+	tests := []struct {
+		name   string
+		maxLag int
+	}{
+		{name: "default lag", maxLag: 0},
+		{name: "lag below size", maxLag: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			const size = 8
+			q := NewFixedQueue[int](size)
+			subscription := q.Subscribe(SubscribeOptions{Policy: DropNewest, MaxLag: test.maxLag})
+			defer subscription.Close()
+
+			maxLag := test.maxLag
+			if maxLag == 0 {
+				maxLag = size
+			}
+
+			// the ring is overwritten twice, the kept elements are not
+			for i := 1; i <= 2*size; i++ {
+				q.Push(i)
+			}
+			for want := 1; want <= maxLag; want++ {
+				item := nextValue(t, subscription)
+				if item.Value != want || item.Sequence != uint64(want) || item.Missed != 0 {
+					t.Fatalf("got %+v, want %d", item, want)
+				}
+			}
+			expectEmpty(t, subscription)
+
+			// the skipped ones are reported with the next element
+			q.Push(2*size + 1)
+			item := nextValue(t, subscription)
+			if item.Value != 2*size+1 || item.Missed != uint64(2*size-maxLag) {
+				t.Fatalf("got %+v, want %d after %d missed", item, 2*size+1, 2*size-maxLag)
+			}
+			if missed := subscription.Missed(); missed != uint64(2*size-maxLag) {
+				t.Fatalf("missed %d, want %d", missed, 2*size-maxLag)
+			}
+		})
+	}
+}
+
+func TestFixedQueueBlockTimeouts(t *testing.T) {
+	q := NewFixedQueue[int](8)
+	short := q.Subscribe(SubscribeOptions{Policy: Block, MaxLag: 2, BlockTimeout: time.Millisecond * 50})
+	defer short.Close()
+	long := q.Subscribe(SubscribeOptions{Policy: Block, MaxLag: 2, BlockTimeout: time.Minute})
+	defer long.Close()
+
+	for i := 1; i <= 5; i++ {
+		q.Push(i)
+	}
+	time.Sleep(time.Millisecond * 100)
+
+	// the short subscriber is over its timeout and loses the oldest elements
+	// down to the lag, the long one is still within its own
+	if item := nextValue(t, short); item.Value != 4 || item.Missed != 3 {
+		t.Fatalf("got %+v, want 4 after 3 missed", item)
+	}
+	for want := 1; want <= 5; want++ {
+		if item := nextValue(t, long); item.Value != want || item.Missed != 0 {
+			t.Fatalf("got %+v, want %d", item, want)
+		}
+	}
+
+	// the timeout starts over once the subscriber has caught up
+	for i := 6; i <= 9; i++ {
+		q.Push(i)
+	}
+	if item := nextValue(t, short); item.Value != 5 || item.Missed != 0 {
+		t.Fatalf("got %+v, want 5", item)
+	}
+}
+
+func TestFixedQueueBlockDoesNotHoldPush(t *testing.T) {
+	q := NewFixedQueue[int](8)
+	subscription := q.Subscribe(SubscribeOptions{Policy: Block, MaxLag: 1, BlockTimeout: time.Minute})
+	defer subscription.Close()
+
+	// the producer never waits for the subscriber, the other subscribers and
+	// the critical lane are not held up
+	started := time.Now()
+	for i := 1; i <= 20; i++ {
+		q.Push(i)
+	}
+	if elapsed := time.Since(started); elapsed > time.Millisecond*100 {
+		t.Fatalf("pushes waited %s", elapsed)
+	}
+
+	// within the timeout the subscriber keeps what the ring holds
+	if item := nextValue(t, subscription); item.Value != 13 || item.Missed != 12 {
+		t.Fatalf("got %+v, want 13 after 12 overwritten", item)
+	}
+	for want := 14; want <= 20; want++ {
+		if item := nextValue(t, subscription); item.Value != want || item.Missed != 0 {
+			t.Fatalf("got %+v, want %d", item, want)
+		}
+	}
+}
+
+func TestFixedQueueWakesOnPush(t *testing.T) {
+	q := NewFixedQueue[int](8)
+	subscription := q.Subscribe(SubscribeOptions{})
+	defer subscription.Close()
+
+	received := make(chan Item[int])
+	go func() {
+		item, err := subscription.Next(context.Background())
+		if err == nil {
+			received <- item
+		}
+	}()
+
+	select {
+	case item := <-received:
+		t.Fatalf("got %+v before push", item)
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	pushed := time.Now()
+	q.Push(1)
+
+	select {
+	case item := <-received:
+		if item.Value != 1 {
+			t.Fatalf("got %+v, want 1", item)
+		}
+		// a sleep polling subscriber would be late by its polling interval
+		if delay := time.Since(pushed); delay > time.Millisecond*100 {
+			t.Fatalf("woken %s after push", delay)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("not woken by push")
+	}
+}
+
+func TestFixedQueueNextCancelled(t *testing.T) {
+	q := NewFixedQueue[int](8)
+	subscription := q.Subscribe(SubscribeOptions{})
+	defer subscription.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+
+	_, err := subscription.Next(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want deadline exceeded", err)
+	}
+}
+
+func TestFixedQueueClose(t *testing.T) {
+	q := NewFixedQueue[int](4)
+	subscription := q.Subscribe(SubscribeOptions{Policy: Block, BlockTimeout: time.Minute})
+	for i := 1; i <= 4; i++ {
+		q.Push(i)
+	}
+
+	subscription.Close()
+
+	_, err := subscription.Next(context.Background())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want canceled", err)
+	}
+
+	// a new subscriber gets the elements the closed one has not taken
+	late := q.Subscribe(SubscribeOptions{})
+	defer late.Close()
+	if item := nextValue(t, late); item.Value != 1 {
+		t.Fatalf("got %+v, want 1", item)
+	}
+}
+
+func TestFixedQueueCloseWakesNext(t *testing.T) {
+	q := NewFixedQueue[int](4)
+	subscription := q.Subscribe(SubscribeOptions{})
+
+	done := make(chan error)
+	go func() {
+		_, err := subscription.Next(context.Background())
+		done <- err
+	}()
+
+	time.Sleep(time.Millisecond * 20)
+	subscription.Close()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("got %v, want canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("next is not woken by close")
+	}
+}
+
+// BenchmarkPushToReceive measures the time from Push to a waiting subscriber
+// getting the element.
+func BenchmarkPushToReceive(b *testing.B) {
+	q := NewFixedQueue[time.Time](1024)
+	subscription := q.Subscribe(SubscribeOptions{})
+	defer subscription.Close()
+
+	received := make(chan struct{})
+	var latency time.Duration
+	go func() {
+		for i := 0; i < b.N; i++ {
+			item, err := subscription.Next(context.Background())
+			if err != nil {
+				return
+			}
+			latency += time.Since(item.Value)
+			received <- struct{}{}
+		}
+	}()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.Push(time.Now())
+		<-received
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(latency.Nanoseconds())/float64(b.N), "ns/latency")
+}
+
+// BenchmarkThroughput pushes to several subscribers of a lossless queue as
+// fast as they take.
+func BenchmarkThroughput(b *testing.B) {
+	q := NewLosslessQueue[int](4096)
+
+	wg := &sync.WaitGroup{}
+	subscriptions := make([]*Subscription[int], throughputSubscribers)
+	for i := range subscriptions {
+		subscriptions[i] = q.Subscribe(SubscribeOptions{})
+		defer subscriptions[i].Close()
+	}
+
+	b.ResetTimer()
+	started := time.Now()
+
+	for _, subscription := range subscriptions {
+		wg.Add(1)
+		go func(subscription *Subscription[int]) {
+			defer wg.Done()
+			for i := 0; i < b.N; i++ {
+				item, err := subscription.Next(context.Background())
+				if err != nil || item.Missed > 0 {
+					b.Errorf("subscriber lost elements: %v", err)
+					return
+				}
+			}
+		}(subscription)
+	}
+
+	for i := 0; i < b.N; i++ {
+		q.Push(i)
+	}
+	wg.Wait()
+
+	elapsed := time.Since(started)
+	b.StopTimer()
+
+	rate := float64(b.N) / elapsed.Seconds()
+	b.ReportMetric(rate, "msg/s")
+	if b.N >= 100000 && rate < 10000 {
+		b.Fatalf("%.0f messages per second, want 10k at least", rate)
+	}
+}
@@ -0,0 +1,103 @@
+package queue
+
+import "context"
+
+type Lane int
+
+const (
+	// LaneCritical is lossless and delivered ahead of market data, its ring
+	// grows while a subscriber falls behind or nobody listens
+	LaneCritical Lane = iota
+	// LaneMarket is bounded and lossy according to the subscriber policy
+	LaneMarket
+)
+
+// LanedQueue keeps trading events apart from high volume market data so that
+// a burst of quotes can not evict a fill notification.
+type LanedQueue[T interface{}] struct {
+	critical *FixedQueue[T]
+	market   *FixedQueue[T]
+}
+
+type LanedSubscription[T interface{}] struct {
+	critical *Subscription[T]
+	market   *Subscription[T]
+}
+
+func NewLanedQueue[T interface{}](criticalSize int, marketSize int) *LanedQueue[T] {
+	return &LanedQueue[T]{
+		critical: NewLosslessQueue[T](criticalSize),
+		market:   NewFixedQueue[T](marketSize),
+	}
+}
+
+func (q *LanedQueue[T]) Push(lane Lane, element T) uint64 {
+	if lane == LaneCritical {
+		return q.critical.Push(element)
+	}
+
+	return q.market.Push(element)
+}
+
+// Subscribe applies options to the market lane only, the critical lane never drops.
+func (q *LanedQueue[T]) Subscribe(options SubscribeOptions) *LanedSubscription[T] {
+	return &LanedSubscription[T]{
+		critical: q.critical.Subscribe(SubscribeOptions{}),
+		market:   q.market.Subscribe(options),
+	}
+}
+
+// Next prefers the critical lane, market data is taken only when no trading
+// event is pending.
+func (s *LanedSubscription[T]) Next(ctx context.Context) (Item[T], error) {
+	for {
+		item, ok, criticalNotify, marketNotify, err := s.tryNext()
+		if err != nil || ok {
+			return item, err
+		}
+
+		select {
+		case <-criticalNotify:
+		case <-marketNotify:
+		case <-ctx.Done():
+			return Item[T]{}, ctx.Err()
+		}
+	}
+}
+
+// TryNext is Next that does not wait when both lanes are drained.
+func (s *LanedSubscription[T]) TryNext() (Item[T], bool, error) {
+	item, ok, _, _, err := s.tryNext()
+
+	return item, ok, err
+}
+
+func (s *LanedSubscription[T]) tryNext() (Item[T], bool, <-chan struct{}, <-chan struct{}, error) {
+	item, ok, criticalNotify, err := s.critical.tryNext()
+	if err != nil || ok {
+		item.Lane = LaneCritical
+		return item, ok, nil, nil, err
+	}
+
+	item, ok, marketNotify, err := s.market.tryNext()
+	if err != nil || ok {
+		item.Lane = LaneMarket
+		return item, ok, nil, nil, err
+	}
+
+	return item, false, criticalNotify, marketNotify, nil
+}
+
+func (s *LanedSubscription[T]) Close() {
+	s.critical.Close()
+	s.market.Close()
+}
+
+func (s *LanedSubscription[T]) Lag() uint64 {
+	return s.critical.Lag() + s.market.Lag()
+}
+
+// Missed counts market data only, the critical lane never loses anything.
+func (s *LanedSubscription[T]) Missed() uint64 {
+	return s.market.Missed()
+}
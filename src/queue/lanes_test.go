@@ -0,0 +1,111 @@
+package queue
+
+import (
+	"testing"
+)
+
+func nextLaned(t *testing.T, subscription *LanedSubscription[int]) Item[int] {
+	t.Helper()
+
+	item, ok, err := subscription.TryNext()
+	if err != nil || !ok {
+		t.Fatalf("an item expected, got %v", err)
+	}
+
+	return item
+}
+
+func TestLanedQueueCriticalSurvivesMarketFlood(t *testing.T) {
+	q := NewLanedQueue[int](4, 8)
+	subscription := q.Subscribe(SubscribeOptions{Policy: DropOldest})
+	defer subscription.Close()
+
+	// a trading event after every 100 quotes, nobody takes anything meanwhile
+	var critical []int
+	for i := 1; i <= 1000; i++ {
+		if i%100 == 0 {
+			q.Push(LaneCritical, i)
+			critical = append(critical, i)
+			continue
+		}
+		q.Push(LaneMarket, i)
+	}
+
+	// the trading events come first and in order
+	for _, want := range critical {
+		item := nextLaned(t, subscription)
+		if item.Lane != LaneCritical || item.Value != want || item.Missed != 0 {
+			t.Fatalf("got %+v, want critical %d", item, want)
+		}
+	}
+
+	// the market lane kept its last 8 quotes
+	item := nextLaned(t, subscription)
+	if item.Lane != LaneMarket || item.Value != 992 || item.Missed != 982 {
+		t.Fatalf("got %+v, want market 992 after 982 missed", item)
+	}
+	if missed := subscription.Missed(); missed != 982 {
+		t.Fatalf("missed %d, want 982", missed)
+	}
+}
+
+func TestLanedQueueCriticalSlowConsumer(t *testing.T) {
+	q := NewLanedQueue[int](4, 4)
+
+	// pushed while nobody listens, the first subscriber gets them
+	for i := 1; i <= 10; i++ {
+		q.Push(LaneCritical, i)
+	}
+
+	slow := q.Subscribe(SubscribeOptions{Policy: Disconnect})
+	defer slow.Close()
+	fast := q.Subscribe(SubscribeOptions{Policy: Disconnect})
+	defer fast.Close()
+
+	// the slow subscriber falls behind by far more than the lane size
+	for i := 11; i <= 100; i++ {
+		q.Push(LaneCritical, i)
+		if item := nextLaned(t, fast); item.Value < 1 {
+			t.Fatalf("got %+v", item)
+		}
+	}
+
+	for want := 1; want <= 100; want++ {
+		item := nextLaned(t, slow)
+		if item.Value != want || item.Missed != 0 {
+			t.Fatalf("got %+v, want %d", item, want)
+		}
+	}
+	if lag := slow.Lag(); lag != 0 {
+		t.Fatalf("lag %d after everything is taken", lag)
+	}
+}
+
+func TestLosslessQueueKeepsPendingWhileGrowing(t *testing.T) {
+	q := NewLosslessQueue[int](2)
+	subscription := q.Subscribe(SubscribeOptions{Policy: Disconnect, MaxLag: 1})
+	defer subscription.Close()
+
+	// the subscriber takes every third element, the ring grows under it
+	want := 1
+	for i := 1; i <= 50; i++ {
+		q.Push(i)
+		if i%3 != 0 {
+			continue
+		}
+
+		item := nextValue(t, subscription)
+		if item.Value != want || item.Missed != 0 {
+			t.Fatalf("got %+v, want %d", item, want)
+		}
+		want++
+	}
+
+	for ; want <= 50; want++ {
+		item := nextValue(t, subscription)
+		if item.Value != want || item.Missed != 0 {
+			t.Fatalf("got %+v, want %d", item, want)
+		}
+	}
+	expectEmpty(t, subscription)
+}
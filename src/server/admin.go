@@ -0,0 +1,19 @@
+package server
+
+import (
+	"context"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+)
+
+func (s *ConnectService) SetLogLevel(_ context.Context, request *server2.SetLogLevelRequest) (*server2.SetLogLevelResponse, error) {
+	err := s.transaqHandler.SetLogLevel(transaq.LogLevel(request.Level))
+	if err != nil {
+		s.localLogger.Error().Err(err).Msgf("Set log level %d failed", request.Level)
+		return nil, statusError(err, nil)
+	}
+
+	s.localLogger.Warn().Msgf("Connector log level changed to %d", request.Level)
+
+	return &server2.SetLogLevelResponse{}, nil
+}
@@ -0,0 +1,181 @@
+package server
+
+import (
+	"container/list"
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+)
+
+// entries held by a conflating stream before it stops draining the subscription
+const conflationBufferSize = 1024
+
+type messageSource interface {
+	Next(ctx context.Context) (queue.Item[transaq.Message], error)
+}
+
+type conflatedEntry struct {
+	item     queue.Item[transaq.Message]
+	root     string
+	security string
+	elements []*messages.MergeElement
+	index    map[string]*messages.MergeElement
+	// the updates merged into the entry
+	sequences []uint64
+}
+
+// conflatingSource drains whatever is already queued for the client before
+// every send and merges quotations and quotes of the same security, so a busy
+// client receives the latest state instead of every intermediate update.
+// Trading events are passed through untouched and ahead of market data.
+// A message is delivered once every entry it is merged into has been popped.
+type conflatingSource struct {
+	subscription *queue.LanedSubscription[transaq.Message]
+	watermark    *deliveryWatermark
+	critical     *list.List
+	market       *list.List
+	slots        map[string]*conflatedEntry
+	// entries not popped yet by sequence
+	pending map[uint64]int
+	// missed market data not reported yet
+	missed uint64
+}
+
+func newConflatingSource(
+	subscription *queue.LanedSubscription[transaq.Message],
+	watermark *deliveryWatermark,
+) *conflatingSource {
+	return &conflatingSource{
+		subscription: subscription,
+		watermark:    watermark,
+		critical:     list.New(),
+		market:       list.New(),
+		slots:        map[string]*conflatedEntry{},
+		pending:      map[uint64]int{},
+	}
+}
+
+func (c *conflatingSource) Next(ctx context.Context) (queue.Item[transaq.Message], error) {
+	for c.critical.Len()+c.market.Len() < conflationBufferSize {
+		item, ok, err := c.subscription.TryNext()
+		if err != nil {
+			return item, err
+		}
+		if !ok {
+			break
+		}
+
+		c.add(item)
+	}
+
+	// an empty quotations message adds nothing
+	for c.critical.Len() == 0 && c.market.Len() == 0 {
+		item, err := c.subscription.Next(ctx)
+		if err != nil {
+			return item, err
+		}
+
+		c.add(item)
+	}
+
+	return c.pop(), nil
+}
+
+func (c *conflatingSource) add(item queue.Item[transaq.Message]) {
+	if item.Lane == queue.LaneCritical {
+		c.critical.PushBack(c.cover(&conflatedEntry{item: item}, item.Value.Sequence))
+		return
+	}
+
+	c.missed += item.Missed
+	item.Missed = 0
+
+	root := item.Value.Type
+	if !messages.Mergeable(root) {
+		c.market.PushBack(c.cover(&conflatedEntry{item: item}, item.Value.Sequence))
+		return
+	}
+
+	elements, err := messages.SplitForMerge(item.Value.Data)
+	if err != nil {
+		c.market.PushBack(c.cover(&conflatedEntry{item: item}, item.Value.Sequence))
+		return
+	}
+	if len(elements) == 0 {
+		c.watermark.deliver(item.Value.Sequence)
+		return
+	}
+
+	for _, element := range elements {
+		slotKey := root + "|" + element.Security
+
+		entry, ok := c.slots[slotKey]
+		if !ok {
+			entry = &conflatedEntry{
+				root:     root,
+				security: element.Security,
+				index:    map[string]*messages.MergeElement{},
+			}
+			c.slots[slotKey] = entry
+			c.market.PushBack(entry)
+		}
+		// the merged message takes the sequence and the time of the latest update
+		entry.item = item
+		c.cover(entry, item.Value.Sequence)
+
+		merged, ok := entry.index[element.Key]
+		if ok {
+			merged.Merge(element)
+			continue
+		}
+
+		entry.index[element.Key] = element
+		entry.elements = append(entry.elements, element)
+	}
+}
+
+// cover adds the sequence to the entry once.
+func (c *conflatingSource) cover(entry *conflatedEntry, sequence uint64) *conflatedEntry {
+	if len(entry.sequences) > 0 && entry.sequences[len(entry.sequences)-1] == sequence {
+		return entry
+	}
+
+	entry.sequences = append(entry.sequences, sequence)
+	c.pending[sequence]++
+
+	return entry
+}
+
+func (c *conflatingSource) pop() queue.Item[transaq.Message] {
+	if c.critical.Len() > 0 {
+		entry := c.critical.Remove(c.critical.Front()).(*conflatedEntry)
+		c.deliver(entry)
+		return entry.item
+	}
+
+	entry := c.market.Remove(c.market.Front()).(*conflatedEntry)
+	if entry.elements != nil {
+		delete(c.slots, entry.root+"|"+entry.security)
+		entry.item.Value.Data = messages.RenderMerged(entry.root, entry.elements)
+	}
+	c.deliver(entry)
+
+	entry.item.Missed = c.missed
+	c.missed = 0
+
+	return entry.item
+}
+
+// deliver marks the messages with no other entry pending as delivered.
+func (c *conflatingSource) deliver(entry *conflatedEntry) {
+	for _, sequence := range entry.sequences {
+		c.pending[sequence]--
+		if c.pending[sequence] > 0 {
+			continue
+		}
+
+		delete(c.pending, sequence)
+		c.watermark.deliver(sequence)
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"testing"
+	"time"
+)
+
+type conflatedResponse struct {
+	sequence uint64
+	data     string
+	// the watermark after the response is taken
+	through uint64
+}
+
+func pushMessages(q *queue.LanedQueue[transaq.Message], data ...string) {
+	for i, d := range data {
+		root := messages.RootOf(d)
+		lane := queue.LaneMarket
+		if root == messages.RootOrders {
+			lane = queue.LaneCritical
+		}
+		q.Push(lane, transaq.Message{Sequence: uint64(i + 1), Type: root, Data: d})
+	}
+}
+
+func nextConflated(t *testing.T, source *conflatingSource) queue.Item[transaq.Message] {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	item, err := source.Next(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return item
+}
+
+func TestConflatingSource(t *testing.T) {
+	q := queue.NewLanedQueue[transaq.Message](16, 16)
+	subscription := q.Subscribe(queue.SubscribeOptions{})
+	defer subscription.Close()
+	watermark := newDeliveryWatermark(0)
+	source := newConflatingSource(subscription, watermark)
+
+	order := `<orders><order transactionid="1"><status>active</status></order></orders>`
+	allTrades := `<alltrades><trade secid="1"><price>250</price></trade></alltrades>`
+	pushMessages(q,
+		`<quotations><quotation secid="1"><last>1</last><bid>5</bid></quotation></quotations>`,
+		`<quotations><quotation secid="2"><last>2</last></quotation></quotations>`,
+		order,
+		`<quotations><quotation secid="1"><last>4</last><voltoday>7</voltoday></quotation><quotation secid="2"><last>5</last></quotation></quotations>`,
+		`<quotes><quote secid="1"><price>250</price><buy>10</buy></quote></quotes>`,
+		`<quotes><quote secid="1"><price>250</price><buy>-1</buy></quote><quote secid="1"><price>251</price><sell>3</sell></quote></quotes>`,
+		allTrades,
+		// adds nothing
+		`<quotations/>`,
+	)
+
+	want := []conflatedResponse{
+		// the trading event first, nothing before it is delivered yet
+		{sequence: 3, data: order, through: 0},
+		// 4 is merged into gazp as well
+		{
+			sequence: 4,
+			data:     `<quotations><quotation secid="1"><last>4</last><bid>5</bid><voltoday>7</voltoday></quotation></quotations>`,
+			through:  1,
+		},
+		{sequence: 4, data: `<quotations><quotation secid="2"><last>5</last></quotation></quotations>`, through: 4},
+		{
+			sequence: 6,
+			data:     `<quotes><quote secid="1"><price>250</price><buy>-1</buy></quote><quote secid="1"><price>251</price><sell>3</sell></quote></quotes>`,
+			through:  6,
+		},
+		{sequence: 7, data: allTrades, through: 8},
+	}
+
+	for i, w := range want {
+		item := nextConflated(t, source)
+		got := conflatedResponse{sequence: item.Value.Sequence, data: item.Value.Data, through: watermark.through}
+		if got != w {
+			t.Fatalf("response %d is %+v, want %+v", i, got, w)
+		}
+	}
+
+	// drained, the next update waits for a push
+	pushMessages(q, `<quotations><quotation secid="1"><last>9</last></quotation></quotations>`)
+	if item := nextConflated(t, source); item.Value.Data != `<quotations><quotation secid="1"><last>9</last></quotation></quotations>` {
+		t.Fatalf("got %+v", item)
+	}
+}
+
+func TestConflatingSourceReportsMissed(t *testing.T) {
+	q := queue.NewLanedQueue[transaq.Message](4, 4)
+	subscription := q.Subscribe(queue.SubscribeOptions{Policy: queue.DropOldest})
+	defer subscription.Close()
+	source := newConflatingSource(subscription, newDeliveryWatermark(0))
+
+	var updates []string
+	for i := 0; i < 10; i++ {
+		updates = append(updates, fmt.Sprintf(`<quotations><quotation secid="1"><last>%d</last></quotation></quotations>`, i))
+	}
+	pushMessages(q, updates...)
+
+	item := nextConflated(t, source)
+	if item.Missed != 6 || item.Value.Sequence != 10 || item.Value.Data != updates[9] {
+		t.Fatalf("got %+v, want the last update after 6 missed", item)
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"errors"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// statusFromError maps connector and command failures to grpc codes.
+func statusFromError(err error) *status.Status {
+	var validationError *commands.ValidationError
+	var resultError *commands.ResultError
+	var stateError *transaq.StateError
+
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	switch {
+	case errors.Is(err, queue.ErrSlowConsumer):
+		return status.New(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, journal.ErrOutOfRange):
+		return status.New(codes.OutOfRange, err.Error())
+	case errors.As(err, &validationError):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, transaq.ErrInvalidLogLevel):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, transaq.ErrUnknownSubscriber):
+		return status.New(codes.FailedPrecondition, err.Error())
+	case errors.As(err, &stateError):
+		return status.New(codes.FailedPrecondition, err.Error())
+	case errors.As(err, &resultError):
+		if resultError.Result.ErrorResponse {
+			return status.New(codes.InvalidArgument, err.Error())
+		}
+		return status.New(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, commands.ErrUnknownResponse):
+		return status.New(codes.Internal, err.Error())
+	}
+
+	return status.New(codes.Unavailable, err.Error())
+}
+
+// statusError attaches the structured response as a status detail so clients
+// do not have to parse the status message.
+func statusError(err error, details protoiface.MessageV1) error {
+	st := statusFromError(err)
+	if details == nil {
+		return st.Err()
+	}
+
+	withDetails, detailsErr := st.WithDetails(details)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
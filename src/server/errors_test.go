@@ -0,0 +1,58 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestStatusFromError(t *testing.T) {
+	rejected := &commands.ResultError{Result: &commands.Result{Message: "Not enough funds"}}
+	malformed := &commands.ResultError{Result: &commands.Result{ErrorResponse: true, Message: "Wrong command syntax"}}
+
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{name: "status", err: status.Error(codes.NotFound, "not found"), want: codes.NotFound},
+		{name: "slow consumer", err: queue.ErrSlowConsumer, want: codes.ResourceExhausted},
+		{name: "out of journal", err: fmt.Errorf("resume: %w", journal.ErrOutOfRange), want: codes.OutOfRange},
+		{
+			name: "validation",
+			err:  &commands.ValidationError{Command: "neworder", Field: "price", Reason: "is required"},
+			want: codes.InvalidArgument,
+		},
+		{name: "log level", err: transaq.ErrInvalidLogLevel, want: codes.InvalidArgument},
+		{name: "unknown subscriber", err: transaq.ErrUnknownSubscriber, want: codes.FailedPrecondition},
+		{
+			name: "state",
+			err:  &transaq.StateError{State: transaq.ConnectionDisconnected, Command: "neworder"},
+			want: codes.FailedPrecondition,
+		},
+		{name: "rejected by transaq", err: rejected, want: codes.FailedPrecondition},
+		{name: "wrapped rejection", err: fmt.Errorf("cancel: %w", rejected), want: codes.FailedPrecondition},
+		// <error> is the connector refusing the command itself
+		{name: "error response", err: malformed, want: codes.InvalidArgument},
+		{name: "unknown response", err: commands.ErrUnknownResponse, want: codes.Internal},
+		{name: "connector failure", err: errors.New("dll call failed"), want: codes.Unavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := statusFromError(test.err)
+			if got.Code() != test.want {
+				t.Fatalf("got %s, want %s", got.Code(), test.want)
+			}
+			if got.Message() != status.Convert(test.err).Message() {
+				t.Fatalf("got message %q, want %q", got.Message(), test.err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+// messageFilter returns nil when the request does not filter.
+func messageFilter(request *server2.MessageFilter) (*messages.Filter, error) {
+	if request == nil {
+		return nil, nil
+	}
+
+	securities := make([]messages.SecurityKey, 0, len(request.Securities))
+	for _, security := range request.Securities {
+		securities = append(securities, messages.SecurityKey{SecCode: security.Seccode, Board: security.Board})
+	}
+
+	markets := make([]string, 0, len(request.Markets))
+	for _, market := range request.Markets {
+		markets = append(markets, strconv.Itoa(int(market)))
+	}
+
+	filter, err := messages.NewFilter(request.Types, securities, request.Clients, markets)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "filter: %s", err)
+	}
+
+	return filter, nil
+}
+
+func filterMessage(filter *messages.Filter, message transaq.Message) (transaq.Message, bool) {
+	if filter == nil {
+		return message, true
+	}
+
+	data, ok := filter.Apply(message.Type, message.Data)
+	message.Data = data
+
+	return message, ok
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ConnectService) ReplayJournal(request *server2.ReplayJournalRequest, srv server2.ConnectService_ReplayJournalServer) error {
+	if s.journal == nil {
+		return status.Error(codes.FailedPrecondition, "journal is disabled")
+	}
+	if request.ToSequence != 0 && request.ToSequence < request.FromSequence {
+		return status.Error(codes.InvalidArgument, "to_sequence is less than from_sequence")
+	}
+
+	ctx := srv.Context()
+	var count uint64
+
+	err := s.journal.Replay(request.FromSequence, request.ToSequence, func(record journal.Record) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		count++
+		return srv.Send(&server2.JournalRecord{
+			Sequence:   record.Sequence,
+			ReceivedAt: timestamppb.New(record.Time),
+			Message:    record.Data,
+		})
+	})
+	if err != nil {
+		s.localLogger.Warn().Err(err).Msgf("Journal replay from %d stopped after %d messages", request.FromSequence, count)
+		return statusError(err, nil)
+	}
+
+	s.localLogger.Info().Msgf("Journal replay from %d sent %d messages", request.FromSequence, count)
+
+	return nil
+}
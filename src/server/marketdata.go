@@ -0,0 +1,318 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync/atomic"
+)
+
+// securitySelector passes every security when empty.
+type securitySelector map[commands.SecurityRef]struct{}
+
+func (s securitySelector) selected(board string, secCode string) bool {
+	if len(s) == 0 {
+		return true
+	}
+
+	_, ok := s[commands.SecurityRef{Board: board, SecCode: secCode}]
+	return ok
+}
+
+// marketDataEmitter sends the selected elements of a parsed callback message,
+// missed is reported with the first element sent. It returns whether anything
+// was sent.
+type marketDataEmitter func(message transaq.Message, parsed messages.Message, selector securitySelector, missed uint64) (bool, error)
+
+func (s *ConnectService) StreamQuotations(request *server2.MarketDataStreamRequest, srv server2.ConnectService_StreamQuotationsServer) error {
+	return s.streamMarketData(srv.Context(), request, transaq.MarketDataQuotations,
+		func(message transaq.Message, parsed messages.Message, selector securitySelector, missed uint64) (bool, error) {
+			sent := false
+			for _, item := range parsed.(*messages.Quotations).Items {
+				if !selector.selected(item.Board, item.SecCode) {
+					continue
+				}
+
+				err := srv.Send(toProtoQuotation(item, message, missed))
+				if err != nil {
+					return sent, err
+				}
+				sent = true
+				missed = 0
+			}
+
+			return sent, nil
+		})
+}
+
+func (s *ConnectService) StreamTrades(request *server2.MarketDataStreamRequest, srv server2.ConnectService_StreamTradesServer) error {
+	return s.streamMarketData(srv.Context(), request, transaq.MarketDataAllTrades,
+		func(message transaq.Message, parsed messages.Message, selector securitySelector, missed uint64) (bool, error) {
+			sent := false
+			for _, item := range parsed.(*messages.AllTrades).Items {
+				if !selector.selected(item.Board, item.SecCode) {
+					continue
+				}
+
+				err := srv.Send(toProtoMarketTrade(item, message, missed))
+				if err != nil {
+					return sent, err
+				}
+				sent = true
+				missed = 0
+			}
+
+			return sent, nil
+		})
+}
+
+func (s *ConnectService) StreamOrderBook(request *server2.MarketDataStreamRequest, srv server2.ConnectService_StreamOrderBookServer) error {
+	return s.streamMarketData(srv.Context(), request, transaq.MarketDataQuotes,
+		func(message transaq.Message, parsed messages.Message, selector securitySelector, missed uint64) (bool, error) {
+			sent := false
+			for _, update := range toProtoOrderBookUpdates(parsed.(*messages.Quotes).Items, message) {
+				if !selector.selected(update.Board, update.Seccode) {
+					continue
+				}
+
+				update.Missed = missed
+				err := srv.Send(update)
+				if err != nil {
+					return sent, err
+				}
+				sent = true
+				missed = 0
+			}
+
+			return sent, nil
+		})
+}
+
+// streamMarketData decodes the callback messages of the kind from the queue
+// until the client goes away.
+func (s *ConnectService) streamMarketData(
+	ctx context.Context,
+	request *server2.MarketDataStreamRequest,
+	kind transaq.MarketDataKind,
+	emit marketDataEmitter,
+) error {
+	options, err := subscribeOptions(&server2.DataRequest{
+		SlowConsumerPolicy: request.SlowConsumerPolicy,
+		MaxLag:             request.MaxLag,
+	})
+	if err != nil {
+		return statusError(err, nil)
+	}
+
+	selector, streams, err := securityStreams(request.Securities, kind)
+	if err != nil {
+		return err
+	}
+	if request.Subscribe && len(streams) == 0 {
+		return status.Error(codes.InvalidArgument, "securities are required to subscribe")
+	}
+
+	subscription := s.messagesQueue.Subscribe(options)
+	defer subscription.Close()
+
+	if request.Subscribe {
+		release, err := s.holdStreams(kind, streams)
+		if err != nil {
+			return statusError(err, nil)
+		}
+		defer release()
+	}
+
+	s.localLogger.Info().Msgf("Client connected to %s of %d securities", kind, len(selector))
+
+	var missed uint64
+	for {
+		item, err := subscription.Next(ctx)
+		if errors.Is(err, queue.ErrSlowConsumer) {
+			s.localLogger.Warn().Msgf("Client of %s is too slow, disconnecting with lag %d", kind, subscription.Lag())
+			return statusError(err, nil)
+		}
+		if err != nil {
+			s.localLogger.Info().Msgf("Stream of %s done %s", kind, err)
+			return nil
+		}
+
+		missed += item.Missed
+		if item.Value.Type != string(kind) {
+			continue
+		}
+
+		parsed, err := messages.Parse(item.Value.Data)
+		if err != nil {
+			s.localLogger.Error().Err(err).Msgf("message %s %d parsing failed", kind, item.Value.Sequence)
+			continue
+		}
+
+		sent, err := emit(item.Value, parsed, selector, missed)
+		if err != nil {
+			return err
+		}
+		if sent {
+			missed = 0
+		}
+	}
+}
+
+func securityStreams(
+	securities []*server2.SecurityRef,
+	kind transaq.MarketDataKind,
+) (securitySelector, []transaq.MarketDataStream, error) {
+	selector := securitySelector{}
+	streams := make([]transaq.MarketDataStream, 0, len(securities))
+
+	for _, security := range securities {
+		if security.Board == "" || security.Seccode == "" {
+			return nil, nil, status.Error(codes.InvalidArgument, "security board and seccode are required")
+		}
+
+		ref := commands.SecurityRef{Board: security.Board, SecCode: security.Seccode}
+		if _, ok := selector[ref]; ok {
+			continue
+		}
+		selector[ref] = struct{}{}
+		streams = append(streams, transaq.MarketDataStream{Kind: kind, Security: ref})
+	}
+
+	return selector, streams, nil
+}
+
+// holdStreams subscribes to the streams for the life of a typed stream, the
+// returned release must be called when the stream ends.
+func (s *ConnectService) holdStreams(kind transaq.MarketDataKind, streams []transaq.MarketDataStream) (func(), error) {
+	subscriber := fmt.Sprintf("%s#%d", kind, atomic.AddUint64(&s.streamsCount, 1))
+	s.subscriptions.Attach(subscriber)
+
+	_, err := s.subscriptions.Subscribe(subscriber, streams)
+	if err != nil {
+		s.subscriptions.Detach(subscriber)
+		return nil, err
+	}
+
+	return func() {
+		s.subscriptions.Detach(subscriber)
+	}, nil
+}
+
+func toProtoQuotation(item messages.Quotation, message transaq.Message, missed uint64) *server2.Quotation {
+	return &server2.Quotation{
+		Secid:           int32(item.SecId),
+		Board:           item.Board,
+		Seccode:         item.SecCode,
+		Last:            decimalString(item.Last),
+		Quantity:        item.Quantity,
+		Time:            timestamp(item.Time),
+		Bid:             decimalString(item.Bid),
+		BidDepth:        item.BidDepth,
+		BidDepthTotal:   item.BidDepthT,
+		NumBids:         item.NumBids,
+		Offer:           decimalString(item.Offer),
+		OfferDepth:      item.OfferDepth,
+		OfferDepthTotal: item.OfferDepthT,
+		NumOffers:       item.NumOffers,
+		Open:            decimalString(item.Open),
+		High:            decimalString(item.High),
+		Low:             decimalString(item.Low),
+		ClosePrice:      decimalString(item.ClosePrice),
+		Change:          decimalString(item.Change),
+		Waprice:         decimalString(item.WaPrice),
+		NumTrades:       item.NumTrades,
+		VolToday:        item.VolToday,
+		ValToday:        decimalString(item.ValToday),
+		OpenPositions:   item.OpenPositions,
+		Sequence:        message.Sequence,
+		ReceivedAt:      timestamppb.New(message.Received),
+		Missed:          missed,
+	}
+}
+
+func toProtoMarketTrade(item messages.MarketTrade, message transaq.Message, missed uint64) *server2.MarketTrade {
+	return &server2.MarketTrade{
+		Secid:        int32(item.SecId),
+		Board:        item.Board,
+		Seccode:      item.SecCode,
+		TradeNo:      item.TradeNo,
+		Time:         timestamp(&item.Time),
+		Price:        item.Price.String(),
+		Quantity:     item.Quantity,
+		Buysell:      toProtoBuySell(item.BuySell),
+		OpenInterest: item.OpenInterest,
+		Period:       item.Period,
+		Sequence:     message.Sequence,
+		ReceivedAt:   timestamppb.New(message.Received),
+		Missed:       missed,
+	}
+}
+
+// toProtoOrderBookUpdates groups the levels by security keeping their order.
+func toProtoOrderBookUpdates(items []messages.Quote, message transaq.Message) []*server2.OrderBookUpdate {
+	var updates []*server2.OrderBookUpdate
+	bySecurity := map[commands.SecurityRef]*server2.OrderBookUpdate{}
+
+	for _, item := range items {
+		key := commands.SecurityRef{Board: item.Board, SecCode: item.SecCode}
+		update, ok := bySecurity[key]
+		if !ok {
+			update = &server2.OrderBookUpdate{
+				Secid:      int32(item.SecId),
+				Board:      item.Board,
+				Seccode:    item.SecCode,
+				Sequence:   message.Sequence,
+				ReceivedAt: timestamppb.New(message.Received),
+			}
+			bySecurity[key] = update
+			updates = append(updates, update)
+		}
+
+		update.Levels = append(update.Levels, &server2.OrderBookLevel{
+			Price:  item.Price.String(),
+			Source: item.Source,
+			Yield:  item.Yield,
+			Buy:    item.Buy,
+			Sell:   item.Sell,
+		})
+	}
+
+	return updates
+}
+
+func toProtoBuySell(buySell messages.BuySell) server2.BuySell {
+	switch buySell {
+	case messages.Buy:
+		return server2.BuySell_BUY_SELL_BUY
+	case messages.Sell:
+		return server2.BuySell_BUY_SELL_SELL
+	}
+
+	return server2.BuySell_BUY_SELL_UNSPECIFIED
+}
+
+func decimalString(value *messages.Decimal) *string {
+	if value == nil {
+		return nil
+	}
+
+	text := value.String()
+	return &text
+}
+
+// timestamp is nil for a missing or empty time.
+func timestamp(value *messages.Time) *timestamppb.Timestamp {
+	if value == nil || value.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(value.Time)
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+var orderPhases = map[transaq.OrderPhase]server2.OrderPhase{
+	transaq.OrderPhasePending:   server2.OrderPhase_ORDER_PHASE_PENDING,
+	transaq.OrderPhaseActive:    server2.OrderPhase_ORDER_PHASE_ACTIVE,
+	transaq.OrderPhaseFilled:    server2.OrderPhase_ORDER_PHASE_FILLED,
+	transaq.OrderPhaseCancelled: server2.OrderPhase_ORDER_PHASE_CANCELLED,
+	transaq.OrderPhaseRejected:  server2.OrderPhase_ORDER_PHASE_REJECTED,
+	transaq.OrderPhaseExpired:   server2.OrderPhase_ORDER_PHASE_EXPIRED,
+	transaq.OrderPhaseTriggered: server2.OrderPhase_ORDER_PHASE_TRIGGERED,
+	transaq.OrderPhaseUnknown:   server2.OrderPhase_ORDER_PHASE_UNKNOWN,
+}
+
+const (
+	defaultOrderWait = time.Second * 10
+	maxOrderWait     = time.Minute
+)
+
+func (s *ConnectService) GetOrder(_ context.Context, request *server2.GetOrderRequest) (*server2.Order, error) {
+	if request.TransactionId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "transaction_id must be positive")
+	}
+
+	order, ok := s.orderTracker.Order(request.TransactionId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "order %d is not known", request.TransactionId)
+	}
+
+	return toProtoOrder(&order), nil
+}
+
+func (s *ConnectService) ListOrders(_ context.Context, request *server2.ListOrdersRequest) (*server2.ListOrdersResponse, error) {
+	query := transaq.OrderQuery{Client: request.Client, ActiveOnly: request.ActiveOnly}
+	for _, security := range request.Securities {
+		if security.Board == "" || security.Seccode == "" {
+			return nil, status.Error(codes.InvalidArgument, "security board and seccode are required")
+		}
+		query.Securities = append(query.Securities, commands.SecurityRef{Board: security.Board, SecCode: security.Seccode})
+	}
+
+	orders := s.orderTracker.Orders(query)
+	response := &server2.ListOrdersResponse{Orders: make([]*server2.Order, 0, len(orders))}
+	for i := range orders {
+		response.Orders = append(response.Orders, toProtoOrder(&orders[i]))
+	}
+
+	return response, nil
+}
+
+func (s *ConnectService) WatchOrder(request *server2.WatchOrderRequest, srv server2.ConnectService_WatchOrderServer) error {
+	if request.TransactionId <= 0 {
+		return status.Error(codes.InvalidArgument, "transaction_id must be positive")
+	}
+
+	wait := defaultOrderWait
+	if request.Wait != nil {
+		wait = request.Wait.AsDuration()
+		if wait <= 0 || wait > maxOrderWait {
+			return status.Errorf(codes.InvalidArgument, "wait must be in range (0, %s]", maxOrderWait)
+		}
+	}
+
+	updates := s.orderTracker.Subscribe()
+	defer updates.Close()
+
+	// the order is taken after the subscription, the changes it holds are skipped
+	var sequence uint64
+	sendOrder := func(order transaq.TrackedOrder) (bool, error) {
+		if order.Sequence <= sequence {
+			return false, nil
+		}
+		sequence = order.Sequence
+
+		return order.Complete(), srv.Send(toProtoOrder(&order))
+	}
+	sendKnown := func() (bool, error) {
+		order, ok := s.orderTracker.Order(request.TransactionId)
+		if !ok {
+			return false, nil
+		}
+		return sendOrder(order)
+	}
+
+	done, err := sendKnown()
+	if err != nil || done {
+		return err
+	}
+
+	s.localLogger.Info().Msgf("Client watches order %d", request.TransactionId)
+
+	// the deadline holds until the order shows up
+	ctx := srv.Context()
+	waitCtx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	for {
+		nextCtx := ctx
+		if sequence == 0 {
+			nextCtx = waitCtx
+		}
+
+		item, err := updates.Next(nextCtx)
+		if err != nil && ctx.Err() == nil && sequence == 0 {
+			return status.Errorf(codes.NotFound, "order %d is not known after %s", request.TransactionId, wait)
+		}
+		if err != nil {
+			s.localLogger.Info().Msgf("Order stream done %s", err)
+			return nil
+		}
+
+		if item.Missed > 0 {
+			s.localLogger.Warn().Msgf("Order client lost %d updates, sending the order again", item.Missed)
+			done, err = sendKnown()
+			if err != nil || done {
+				return err
+			}
+		}
+		if item.Value.TransactionId != request.TransactionId {
+			continue
+		}
+
+		done, err = sendOrder(item.Value)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+func toProtoOrder(order *transaq.TrackedOrder) *server2.Order {
+	phase := order.Phase()
+	result := &server2.Order{
+		TransactionId: order.TransactionId,
+		StopOrder:     order.Stop,
+		Orderno:       order.OrderNo,
+		Secid:         int32(order.SecId),
+		Board:         order.Security.Board,
+		Seccode:       order.Security.SecCode,
+		Client:        order.Client,
+		Union:         order.Union,
+		Buysell:       toProtoBuySell(order.BuySell),
+		Price:         order.Price.String(),
+		Quantity:      order.Quantity,
+		Balance:       order.Balance,
+		Filled:        order.Filled(),
+		Status:        string(order.Status),
+		Phase:         orderPhases[phase],
+		Terminal:      phase.Terminal(),
+		Result:        order.Result,
+		Transitions:   make([]*server2.OrderTransition, 0, len(order.Transitions)),
+		Fills:         make([]*server2.OrderFill, 0, len(order.Fills)),
+		Sequence:      order.Sequence,
+		CreatedAt:     timestamppb.New(order.Created),
+		UpdatedAt:     timestamppb.New(order.Updated),
+	}
+
+	for _, transition := range order.Transitions {
+		result.Transitions = append(result.Transitions, &server2.OrderTransition{
+			Status:  string(transition.Status),
+			Phase:   orderPhases[transaq.PhaseOf(transition.Status)],
+			Balance: transition.Balance,
+			Result:  transition.Result,
+			Time:    timestamppb.New(transition.Time),
+		})
+	}
+	for _, fill := range order.Fills {
+		result.Fills = append(result.Fills, &server2.OrderFill{
+			Tradeno:   fill.TradeNo,
+			Price:     fill.Price.String(),
+			Quantity:  fill.Quantity,
+			Value:     fill.Value.String(),
+			Comission: fill.Comission.String(),
+			Time:      timestamp(&fill.Time),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"testing"
+	"time"
+)
+
+type orderStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	orders chan *server2.Order
+}
+
+func (s *orderStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *orderStream) Send(order *server2.Order) error {
+	s.orders <- order
+	return nil
+}
+
+func watchOrder(t *testing.T, service *ConnectService, request *server2.WatchOrderRequest) (*orderStream, chan error) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := &orderStream{ctx: ctx, orders: make(chan *server2.Order, 10)}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- service.WatchOrder(request, stream)
+	}()
+
+	return stream, done
+}
+
+func receiveOrder(t *testing.T, stream *orderStream) *server2.Order {
+	t.Helper()
+
+	select {
+	case order := <-stream.orders:
+		return order
+	case <-time.After(time.Second):
+		t.Fatal("no order")
+		return nil
+	}
+}
+
+func waitDone(t *testing.T, done chan error) error {
+	t.Helper()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(time.Second):
+		t.Fatal("the stream is not done")
+		return nil
+	}
+}
+
+func TestWatchOrderUnknown(t *testing.T) {
+	service, _ := newTestService(t)
+
+	_, done := watchOrder(t, service, &server2.WatchOrderRequest{
+		TransactionId: 7,
+		Wait:          durationpb.New(time.Millisecond * 50),
+	})
+
+	if err := waitDone(t, done); status.Code(err) != codes.NotFound {
+		t.Fatalf("got %v, want %s", err, codes.NotFound)
+	}
+}
+
+func TestWatchOrderInvalidWait(t *testing.T) {
+	service, _ := newTestService(t)
+
+	_, done := watchOrder(t, service, &server2.WatchOrderRequest{TransactionId: 7, Wait: durationpb.New(time.Hour)})
+	if err := waitDone(t, done); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got %v, want %s", err, codes.InvalidArgument)
+	}
+}
+
+func TestWatchOrderWaitsForLateTrades(t *testing.T) {
+	service, connector := newTestService(t)
+
+	stream, done := watchOrder(t, service, &server2.WatchOrderRequest{
+		TransactionId: 7,
+		Wait:          durationpb.New(time.Millisecond * 200),
+	})
+
+	// shows up within the wait, the deadline is gone afterwards
+	connector.callback(`<orders><order transactionid="7"><orderno>100</orderno><status>active</status>` +
+		`<quantity>10</quantity><balance>10</balance></order></orders>`)
+	if order := receiveOrder(t, stream); order.Phase != server2.OrderPhase_ORDER_PHASE_ACTIVE {
+		t.Fatalf("got %v", order)
+	}
+	time.Sleep(time.Millisecond * 300)
+
+	connector.callback(`<orders><order transactionid="7"><status>matched</status><balance>0</balance></order></orders>`)
+	if order := receiveOrder(t, stream); order.Phase != server2.OrderPhase_ORDER_PHASE_FILLED {
+		t.Fatalf("got %v", order)
+	}
+
+	// matched, but the trades are still on their way
+	select {
+	case err := <-done:
+		t.Fatalf("the stream is done before the trades: %v", err)
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	connector.callback(`<trades><trade><tradeno>1</tradeno><orderno>100</orderno><quantity>10</quantity></trade></trades>`)
+	if order := receiveOrder(t, stream); len(order.Fills) != 1 {
+		t.Fatalf("got %v, want the trade", order)
+	}
+	if err := waitDone(t, done); err != nil {
+		t.Fatal(err)
+	}
+}
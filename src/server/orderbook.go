@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ConnectService) GetOrderBook(_ context.Context, request *server2.GetOrderBookRequest) (*server2.OrderBook, error) {
+	if request.Security == nil || request.Security.Board == "" || request.Security.Seccode == "" {
+		return nil, status.Error(codes.InvalidArgument, "security board and seccode are required")
+	}
+
+	security := commands.SecurityRef{Board: request.Security.Board, SecCode: request.Security.Seccode}
+	book, ok := s.orderBooks.Snapshot(security, int(request.Depth))
+	if !ok {
+		return nil, status.Error(codes.NotFound, "no quotes received for the security")
+	}
+
+	return toProtoOrderBook(book), nil
+}
+
+func (s *ConnectService) WatchOrderBook(request *server2.WatchOrderBookRequest, srv server2.ConnectService_WatchOrderBookServer) error {
+	selector, streams, err := securityStreams(request.Securities, transaq.MarketDataQuotes)
+	if err != nil {
+		return err
+	}
+	if len(streams) == 0 {
+		return status.Error(codes.InvalidArgument, "securities are required")
+	}
+
+	updates := s.orderBooks.Subscribe()
+	defer updates.Close()
+
+	if request.Subscribe {
+		release, err := s.holdStreams(transaq.MarketDataQuotes, streams)
+		if err != nil {
+			return statusError(err, nil)
+		}
+		defer release()
+	}
+
+	// the books are taken after the subscription, the deltas pushed in between
+	// are already in them and skipped
+	sequences := make(map[commands.SecurityRef]uint64, len(streams))
+	sendBook := func(security commands.SecurityRef) error {
+		book, _ := s.orderBooks.Snapshot(security, 0)
+		sequences[security] = book.Sequence
+
+		return srv.Send(&server2.OrderBookEvent{Snapshot: toProtoOrderBook(book)})
+	}
+	sendBooks := func() error {
+		for _, stream := range streams {
+			err := sendBook(stream.Security)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err = sendBooks()
+	if err != nil {
+		return err
+	}
+
+	s.localLogger.Info().Msgf("Client watches order books of %d securities", len(streams))
+
+	ctx := srv.Context()
+	for {
+		item, err := updates.Next(ctx)
+		if err != nil {
+			s.localLogger.Info().Msgf("Order book stream done %s", err)
+			return nil
+		}
+
+		if item.Missed > 0 {
+			s.localLogger.Warn().Msgf("Order book client lost %d updates, sending the books again", item.Missed)
+			err = sendBooks()
+			if err != nil {
+				return err
+			}
+		}
+
+		update := item.Value
+		if !selector.selected(update.Security.Board, update.Security.SecCode) {
+			continue
+		}
+		if update.Sequence <= sequences[update.Security] {
+			continue
+		}
+
+		if update.Reset {
+			err = sendBook(update.Security)
+		} else {
+			sequences[update.Security] = update.Sequence
+			err = srv.Send(&server2.OrderBookEvent{Delta: toProtoOrderBookDelta(update)})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func toProtoOrderBook(book transaq.BookSnapshot) *server2.OrderBook {
+	result := &server2.OrderBook{
+		Secid:    int32(book.SecId),
+		Board:    book.Security.Board,
+		Seccode:  book.Security.SecCode,
+		Sequence: book.Sequence,
+		Bids:     toProtoBookLevels(book.Bids),
+		Asks:     toProtoBookLevels(book.Asks),
+	}
+	if !book.Time.IsZero() {
+		result.UpdatedAt = timestamppb.New(book.Time)
+	}
+
+	return result
+}
+
+func toProtoOrderBookDelta(update transaq.BookUpdate) *server2.OrderBookDelta {
+	return &server2.OrderBookDelta{
+		Secid:     int32(update.SecId),
+		Board:     update.Security.Board,
+		Seccode:   update.Security.SecCode,
+		Sequence:  update.Sequence,
+		Bids:      toProtoBookLevels(update.Bids),
+		Asks:      toProtoBookLevels(update.Asks),
+		UpdatedAt: timestamppb.New(update.Time),
+	}
+}
+
+func toProtoBookLevels(levels []transaq.BookLevel) []*server2.BookLevel {
+	result := make([]*server2.BookLevel, 0, len(levels))
+	for _, level := range levels {
+		result = append(result, &server2.BookLevel{
+			Price:    level.Price.String(),
+			Source:   level.Source,
+			Yield:    level.Yield,
+			Quantity: level.Quantity,
+		})
+	}
+
+	return result
+}
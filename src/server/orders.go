@@ -0,0 +1,343 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var unfilledValues = map[server2.OrderUnfilled]commands.Unfilled{
+	server2.OrderUnfilled_ORDER_UNFILLED_PUT_IN_QUEUE: commands.UnfilledPutInQueue,
+	server2.OrderUnfilled_ORDER_UNFILLED_FOK:          commands.UnfilledFOK,
+	server2.OrderUnfilled_ORDER_UNFILLED_IOC:          commands.UnfilledIOC,
+}
+
+var condTypes = map[server2.CondType]commands.CondType{
+	server2.CondType_COND_TYPE_BID:         commands.CondBid,
+	server2.CondType_COND_TYPE_BID_OR_LAST: commands.CondBidOrLast,
+	server2.CondType_COND_TYPE_ASK:         commands.CondAsk,
+	server2.CondType_COND_TYPE_ASK_OR_LAST: commands.CondAskOrLast,
+	server2.CondType_COND_TYPE_TIME:        commands.CondTime,
+	server2.CondType_COND_TYPE_COV_DOWN:    commands.CondCovDown,
+	server2.CondType_COND_TYPE_COV_UP:      commands.CondCovUp,
+	server2.CondType_COND_TYPE_LAST_UP:     commands.CondLastUp,
+	server2.CondType_COND_TYPE_LAST_DOWN:   commands.CondLastDown,
+}
+
+var validityKeywords = map[server2.ValidityKind]commands.Validity{
+	server2.ValidityKind_VALIDITY_KIND_NOW:               commands.ValidNow,
+	server2.ValidityKind_VALIDITY_KIND_TILL_CANCELED:     commands.ValidTillCanceled,
+	server2.ValidityKind_VALIDITY_KIND_TILL_MARKET_CLOSE: commands.ValidTillClose,
+	server2.ValidityKind_VALIDITY_KIND_TILL_MARKET_OPEN:  commands.ValidTillOpen,
+}
+
+func (s *ConnectService) PlaceOrder(_ context.Context, request *server2.PlaceOrderRequest) (*server2.OrderResponse, error) {
+	command := commands.NewOrder{
+		Security:  securityRef(request.Security),
+		Client:    request.Client,
+		Union:     request.Union,
+		Hidden:    request.Hidden,
+		Quantity:  request.Quantity,
+		BuySell:   fromProtoBuySell(request.Buysell),
+		ByMarket:  commands.Flag(request.ByMarket),
+		BrokerRef: request.Brokerref,
+		UseCredit: commands.Flag(request.UseCredit),
+		NoSplit:   commands.Flag(request.NoSplit),
+		ExpDate:   commandTime(request.Expdate),
+	}
+
+	var err error
+	command.Price, err = parseDecimal(command, "price", request.Price)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+
+	unfilled, ok := unfilledValues[request.Unfilled]
+	if !ok {
+		return nil, s.orderError(command, invalidField(command, "unfilled", "is unknown"))
+	}
+	// the connector default, not rendered
+	if unfilled != commands.UnfilledPutInQueue {
+		command.Unfilled = unfilled
+	}
+
+	return s.sendOrder(command)
+}
+
+func (s *ConnectService) PlaceStopOrder(_ context.Context, request *server2.PlaceStopOrderRequest) (*server2.OrderResponse, error) {
+	command := commands.NewStopOrder{
+		Security:      securityRef(request.Security),
+		Client:        request.Client,
+		Union:         request.Union,
+		BuySell:       fromProtoBuySell(request.Buysell),
+		LinkedOrderNo: request.LinkedOrderNo,
+		ExpDate:       commandTime(request.Expdate),
+	}
+
+	var err error
+	command.ValidFor, err = validity(command, "validfor", request.ValidFor)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+
+	if request.StopLoss != nil {
+		command.StopLoss, err = stopLoss(command, request.StopLoss)
+		if err != nil {
+			return nil, s.orderError(command, err)
+		}
+	}
+	if request.TakeProfit != nil {
+		command.TakeProfit, err = takeProfit(command, request.TakeProfit)
+		if err != nil {
+			return nil, s.orderError(command, err)
+		}
+	}
+
+	return s.sendOrder(command)
+}
+
+func (s *ConnectService) PlaceConditionalOrder(
+	_ context.Context,
+	request *server2.PlaceConditionalOrderRequest,
+) (*server2.OrderResponse, error) {
+	command := commands.NewCondOrder{
+		Security:  securityRef(request.Security),
+		Client:    request.Client,
+		Union:     request.Union,
+		Hidden:    request.Hidden,
+		Quantity:  request.Quantity,
+		BuySell:   fromProtoBuySell(request.Buysell),
+		ByMarket:  commands.Flag(request.ByMarket),
+		BrokerRef: request.Brokerref,
+		CondType:  condTypes[request.CondType],
+		CondValue: request.CondValue,
+		UseCredit: commands.Flag(request.UseCredit),
+		NoSplit:   commands.Flag(request.NoSplit),
+		ExpDate:   commandTime(request.Expdate),
+		WithinPos: commands.Flag(request.WithinPos),
+	}
+
+	var err error
+	command.Price, err = parseDecimal(command, "price", request.Price)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+	command.ValidAfter, err = validity(command, "validafter", request.ValidAfter)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+	command.ValidBefore, err = validity(command, "validbefore", request.ValidBefore)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+
+	return s.sendOrder(command)
+}
+
+func (s *ConnectService) CancelOrder(_ context.Context, request *server2.CancelOrderRequest) (*server2.OrderResponse, error) {
+	var command commands.Command = commands.CancelOrder{TransactionId: request.TransactionId}
+	if request.StopOrder {
+		command = commands.CancelStopOrder{TransactionId: request.TransactionId}
+	}
+
+	_, err := s.transaqHandler.Send(command)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+
+	return &server2.OrderResponse{TransactionId: request.TransactionId}, nil
+}
+
+func (s *ConnectService) MoveOrder(_ context.Context, request *server2.MoveOrderRequest) (*server2.OrderResponse, error) {
+	command := commands.MoveOrder{
+		TransactionId: request.TransactionId,
+		MoveFlag:      commands.MoveFlag(request.MoveFlag),
+		Quantity:      request.Quantity,
+	}
+
+	price, err := parseDecimal(command, "price", request.Price)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+	if price != nil {
+		command.Price = *price
+	}
+
+	return s.sendOrder(command)
+}
+
+func (s *ConnectService) sendOrder(command commands.Command) (*server2.OrderResponse, error) {
+	result, err := s.transaqHandler.Send(command)
+	if err != nil {
+		return nil, s.orderError(command, err)
+	}
+
+	s.localLogger.Info().Msgf("Command %s accepted, transaction %d", command.CommandId(), result.TransactionId)
+
+	return &server2.OrderResponse{TransactionId: result.TransactionId}, nil
+}
+
+// orderError attaches the rejection to the status, failures of the connector
+// itself carry no rejection.
+func (s *ConnectService) orderError(command commands.Command, err error) error {
+	s.localLogger.Warn().Err(err).Msgf("Command %s rejected", command.CommandId())
+
+	rejection := orderRejection(err)
+	if rejection == nil {
+		return statusError(err, nil)
+	}
+
+	return statusError(err, &server2.OrderResponse{Rejection: rejection})
+}
+
+func orderRejection(err error) *server2.OrderRejection {
+	var validationError *commands.ValidationError
+	var resultError *commands.ResultError
+	var stateError *transaq.StateError
+
+	switch {
+	case errors.As(err, &validationError):
+		return &server2.OrderRejection{
+			Reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+			Field:   validationError.Field,
+			Message: err.Error(),
+		}
+	case errors.As(err, &stateError):
+		return &server2.OrderRejection{
+			Reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_NOT_CONNECTED,
+			Message: err.Error(),
+		}
+	case errors.As(err, &resultError):
+		reason := server2.OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED
+		if resultError.Result.ErrorResponse {
+			reason = server2.OrderRejectionReason_ORDER_REJECTION_REASON_MALFORMED
+		}
+		return &server2.OrderRejection{Reason: reason, Message: resultError.Result.Message}
+	}
+
+	return nil
+}
+
+func invalidField(command commands.Command, field string, reason string) error {
+	return &commands.ValidationError{Command: command.CommandId(), Field: field, Reason: reason}
+}
+
+// parseDecimal returns nil for an empty value.
+func parseDecimal(command commands.Command, field string, value string) (*decimal.Decimal, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := decimal.NewFromString(value)
+	if err != nil {
+		return nil, invalidField(command, field, "is not a decimal")
+	}
+
+	return &parsed, nil
+}
+
+func validity(command commands.Command, field string, value *server2.Validity) (commands.Validity, error) {
+	if value == nil || value.Kind == server2.ValidityKind_VALIDITY_KIND_UNSPECIFIED {
+		return "", nil
+	}
+
+	if value.Kind == server2.ValidityKind_VALIDITY_KIND_AT {
+		if value.Time == nil {
+			return "", invalidField(command, field, "time is required")
+		}
+		return commands.ValidAt(value.Time.AsTime()), nil
+	}
+
+	keyword, ok := validityKeywords[value.Kind]
+	if !ok {
+		return "", invalidField(command, field, "is unknown")
+	}
+
+	return keyword, nil
+}
+
+func stopLoss(command commands.Command, request *server2.StopLoss) (*commands.StopLoss, error) {
+	result := &commands.StopLoss{
+		ByMarket:  commands.Flag(request.ByMarket),
+		Quantity:  request.Quantity,
+		UseCredit: commands.Flag(request.UseCredit),
+		GuardTime: int(request.GuardTime),
+		BrokerRef: request.Brokerref,
+	}
+
+	activationPrice, err := parseDecimal(command, "stoploss.activationprice", request.ActivationPrice)
+	if err != nil {
+		return nil, err
+	}
+	if activationPrice != nil {
+		result.ActivationPrice = *activationPrice
+	}
+
+	result.OrderPrice, err = parseDecimal(command, "stoploss.orderprice", request.OrderPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func takeProfit(command commands.Command, request *server2.TakeProfit) (*commands.TakeProfit, error) {
+	result := &commands.TakeProfit{
+		Quantity:  request.Quantity,
+		UseCredit: commands.Flag(request.UseCredit),
+		GuardTime: int(request.GuardTime),
+		BrokerRef: request.Brokerref,
+		ByMarket:  commands.Flag(request.ByMarket),
+	}
+
+	activationPrice, err := parseDecimal(command, "takeprofit.activationprice", request.ActivationPrice)
+	if err != nil {
+		return nil, err
+	}
+	if activationPrice != nil {
+		result.ActivationPrice = *activationPrice
+	}
+
+	result.Correction, err = parseDecimal(command, "takeprofit.correction", request.Correction)
+	if err != nil {
+		return nil, err
+	}
+	result.Spread, err = parseDecimal(command, "takeprofit.spread", request.Spread)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func securityRef(security *server2.SecurityRef) commands.SecurityRef {
+	if security == nil {
+		return commands.SecurityRef{}
+	}
+
+	return commands.SecurityRef{Board: security.Board, SecCode: security.Seccode}
+}
+
+func fromProtoBuySell(buySell server2.BuySell) messages.BuySell {
+	switch buySell {
+	case server2.BuySell_BUY_SELL_BUY:
+		return messages.Buy
+	case server2.BuySell_BUY_SELL_SELL:
+		return messages.Sell
+	}
+
+	return ""
+}
+
+func commandTime(value *timestamppb.Timestamp) *commands.Time {
+	if value == nil {
+		return nil
+	}
+
+	return &commands.Time{Time: value.AsTime()}
+}
@@ -0,0 +1,500 @@
+package server
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/client"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+	"time"
+)
+
+const simulatorClient = "SIM0001"
+
+var sber = &server2.SecurityRef{Board: "TQBR", Seccode: "SBER"}
+
+// newSimulatorService is connected to the simulator unless connect is false.
+func newSimulatorService(t *testing.T, connect bool) *ConnectService {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	connector := transaq.NewSimulatorConnector(&logger)
+	messagesQueue := queue.NewLanedQueue[transaq.Message](1000, 1000)
+	handler := transaq.NewTransaqHandler(&logger, connector, messagesQueue, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		connector.Release()
+	})
+
+	err := handler.Init(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewConnectService(
+		handler,
+		messagesQueue,
+		nil,
+		nil,
+		nil,
+		nil,
+		transaq.NewOrderTracker(&logger, handler),
+		client.NewClientExists(),
+		&logger,
+	)
+	if !connect {
+		return service
+	}
+
+	_, err = service.Connect(ctx, &server2.ConnectRequest{Login: "login", Password: "password", Host: "host", Port: 3900})
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "connected", func() bool {
+		return handler.ConnectionStatus().State == transaq.ConnectionConnected
+	})
+
+	return service
+}
+
+func eventually(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second * 3)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s is not reached", what)
+		}
+		time.Sleep(time.Millisecond * 5)
+	}
+}
+
+func waitOrderStatus(t *testing.T, service *ConnectService, transactionId int64, want string) {
+	t.Helper()
+
+	eventually(t, "order "+want, func() bool {
+		order, ok := service.orderTracker.Order(transactionId)
+		return ok && string(order.Status) == want
+	})
+}
+
+// rejectionOf returns the code and the rejection detail of a failed order request.
+func rejectionOf(t *testing.T, err error) (codes.Code, *server2.OrderRejection) {
+	t.Helper()
+
+	if err == nil {
+		t.Fatal("the request succeeded")
+	}
+
+	st := status.Convert(err)
+	for _, detail := range st.Details() {
+		if response, ok := detail.(*server2.OrderResponse); ok {
+			return st.Code(), response.Rejection
+		}
+	}
+
+	return st.Code(), nil
+}
+
+type rejectionCase struct {
+	code   codes.Code
+	reason server2.OrderRejectionReason
+	field  string
+	// a part of the message
+	message string
+}
+
+func expectRejection(t *testing.T, err error, want rejectionCase) {
+	t.Helper()
+
+	code, rejection := rejectionOf(t, err)
+	if code != want.code || rejection == nil {
+		t.Fatalf("got %s with %v, want %s with a rejection", code, rejection, want.code)
+	}
+	if rejection.Reason != want.reason || rejection.Field != want.field || !strings.Contains(rejection.Message, want.message) {
+		t.Fatalf("got %v, want %s %q with %q", rejection, want.reason, want.field, want.message)
+	}
+}
+
+func limitOrder(price string) *server2.PlaceOrderRequest {
+	return &server2.PlaceOrderRequest{
+		Security: sber,
+		Client:   simulatorClient,
+		Buysell:  server2.BuySell_BUY_SELL_BUY,
+		Quantity: 1,
+		Price:    price,
+	}
+}
+
+func TestPlaceOrder(t *testing.T) {
+	service := newSimulatorService(t, true)
+
+	tests := []struct {
+		name    string
+		request *server2.PlaceOrderRequest
+		// the order status after success
+		status    string
+		rejection rejectionCase
+	}{
+		{name: "limit", request: limitOrder("1.00"), status: "active"},
+		{
+			name: "by market",
+			request: &server2.PlaceOrderRequest{
+				Security: sber,
+				Client:   simulatorClient,
+				Buysell:  server2.BuySell_BUY_SELL_SELL,
+				Quantity: 2,
+				ByMarket: true,
+			},
+			status: "matched",
+		},
+		{
+			name:    "price not a decimal",
+			request: limitOrder("1,00"),
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "price",
+			},
+		},
+		{
+			name: "unknown unfilled",
+			request: func() *server2.PlaceOrderRequest {
+				request := limitOrder("1.00")
+				request.Unfilled = 42
+				return request
+			}(),
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "unfilled",
+			},
+		},
+		{
+			name: "no quantity",
+			request: func() *server2.PlaceOrderRequest {
+				request := limitOrder("1.00")
+				request.Quantity = 0
+				return request
+			}(),
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "quantity",
+			},
+		},
+		{
+			name: "unknown security",
+			request: func() *server2.PlaceOrderRequest {
+				request := limitOrder("1.00")
+				request.Security = &server2.SecurityRef{Board: "TQBR", Seccode: "NONE"}
+				return request
+			}(),
+			rejection: rejectionCase{
+				code:    codes.FailedPrecondition,
+				reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED,
+				message: "Unknown security",
+			},
+		},
+		{
+			// a decimal for the service, sber has 2 decimals in Transaq
+			name:    "price beyond the decimals",
+			request: limitOrder("1.005"),
+			rejection: rejectionCase{
+				code:    codes.FailedPrecondition,
+				reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED,
+				message: "price is required",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := service.PlaceOrder(context.Background(), test.request)
+			if test.status == "" {
+				expectRejection(t, err, test.rejection)
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if response.TransactionId <= 0 || response.Rejection != nil {
+				t.Fatalf("got %v", response)
+			}
+			waitOrderStatus(t, service, response.TransactionId, test.status)
+		})
+	}
+}
+
+func TestPlaceOrderNotConnected(t *testing.T) {
+	service := newSimulatorService(t, false)
+
+	_, err := service.PlaceOrder(context.Background(), limitOrder("1.00"))
+	expectRejection(t, err, rejectionCase{
+		code:    codes.FailedPrecondition,
+		reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_NOT_CONNECTED,
+		message: "neworder",
+	})
+}
+
+func TestPlaceStopOrder(t *testing.T) {
+	service := newSimulatorService(t, true)
+
+	stopLoss := func(security *server2.SecurityRef, loss *server2.StopLoss) *server2.PlaceStopOrderRequest {
+		return &server2.PlaceStopOrderRequest{
+			Security: security,
+			Client:   simulatorClient,
+			Buysell:  server2.BuySell_BUY_SELL_SELL,
+			StopLoss: loss,
+		}
+	}
+
+	response, err := service.PlaceStopOrder(context.Background(), stopLoss(sber, &server2.StopLoss{
+		ActivationPrice: "100.00",
+		Quantity:        1,
+		ByMarket:        true,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "watching")
+	order, _ := service.orderTracker.Order(response.TransactionId)
+	if !order.Stop || order.Price.String() != "100" {
+		t.Fatalf("got %+v, want a stop order at 100", order)
+	}
+
+	_, err = service.CancelOrder(context.Background(), &server2.CancelOrderRequest{
+		TransactionId: response.TransactionId,
+		StopOrder:     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "cancelled")
+
+	_, err = service.PlaceStopOrder(context.Background(), stopLoss(sber, nil))
+	expectRejection(t, err, rejectionCase{
+		code:   codes.InvalidArgument,
+		reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+		field:  "stoploss",
+	})
+
+	_, err = service.PlaceStopOrder(context.Background(), stopLoss(sber, &server2.StopLoss{
+		ActivationPrice: "100.00",
+		Quantity:        1,
+	}))
+	expectRejection(t, err, rejectionCase{
+		code:   codes.InvalidArgument,
+		reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+		field:  "stoploss.orderprice",
+	})
+
+	_, err = service.PlaceStopOrder(context.Background(), stopLoss(&server2.SecurityRef{Board: "TQBR", Seccode: "NONE"}, &server2.StopLoss{
+		ActivationPrice: "100.00",
+		Quantity:        1,
+		ByMarket:        true,
+	}))
+	expectRejection(t, err, rejectionCase{
+		code:    codes.FailedPrecondition,
+		reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED,
+		message: "Unknown security",
+	})
+}
+
+func TestPlaceConditionalOrder(t *testing.T) {
+	service := newSimulatorService(t, true)
+
+	condOrder := func() *server2.PlaceConditionalOrderRequest {
+		return &server2.PlaceConditionalOrderRequest{
+			Security:  sber,
+			Client:    simulatorClient,
+			Buysell:   server2.BuySell_BUY_SELL_BUY,
+			Quantity:  1,
+			Price:     "200.00",
+			CondType:  server2.CondType_COND_TYPE_LAST_DOWN,
+			CondValue: "200.00",
+		}
+	}
+
+	response, err := service.PlaceConditionalOrder(context.Background(), condOrder())
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "watching")
+
+	_, err = service.CancelOrder(context.Background(), &server2.CancelOrderRequest{TransactionId: response.TransactionId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "cancelled")
+
+	tests := []struct {
+		name      string
+		modify    func(request *server2.PlaceConditionalOrderRequest)
+		rejection rejectionCase
+	}{
+		{
+			name:   "no condition value",
+			modify: func(request *server2.PlaceConditionalOrderRequest) { request.CondValue = "" },
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "cond_value",
+			},
+		},
+		{
+			name: "valid before now",
+			modify: func(request *server2.PlaceConditionalOrderRequest) {
+				request.ValidBefore = &server2.Validity{Kind: server2.ValidityKind_VALIDITY_KIND_NOW}
+			},
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "validbefore",
+			},
+		},
+		{
+			name:   "no price",
+			modify: func(request *server2.PlaceConditionalOrderRequest) { request.Price = "" },
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "price",
+			},
+		},
+		{
+			name:   "unknown security",
+			modify: func(request *server2.PlaceConditionalOrderRequest) { request.Security.Seccode = "NONE" },
+			rejection: rejectionCase{
+				code:    codes.FailedPrecondition,
+				reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED,
+				message: "Unknown security",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := condOrder()
+			request.Security = &server2.SecurityRef{Board: sber.Board, Seccode: sber.Seccode}
+			test.modify(request)
+
+			_, err := service.PlaceConditionalOrder(context.Background(), request)
+			expectRejection(t, err, test.rejection)
+		})
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	service := newSimulatorService(t, true)
+
+	response, err := service.PlaceOrder(context.Background(), limitOrder("1.00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "active")
+
+	cancelled, err := service.CancelOrder(context.Background(), &server2.CancelOrderRequest{TransactionId: response.TransactionId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.TransactionId != response.TransactionId {
+		t.Fatalf("got %v", cancelled)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "cancelled")
+
+	// already cancelled
+	_, err = service.CancelOrder(context.Background(), &server2.CancelOrderRequest{TransactionId: response.TransactionId})
+	expectRejection(t, err, rejectionCase{
+		code:    codes.FailedPrecondition,
+		reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED,
+		message: "not found",
+	})
+
+	_, err = service.CancelOrder(context.Background(), &server2.CancelOrderRequest{})
+	expectRejection(t, err, rejectionCase{
+		code:   codes.InvalidArgument,
+		reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+		field:  "transactionid",
+	})
+}
+
+func TestMoveOrder(t *testing.T) {
+	service := newSimulatorService(t, true)
+
+	response, err := service.PlaceOrder(context.Background(), limitOrder("1.00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "active")
+
+	// the order is replaced by a new one
+	moved, err := service.MoveOrder(context.Background(), &server2.MoveOrderRequest{
+		TransactionId: response.TransactionId,
+		Price:         "2.00",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.TransactionId == response.TransactionId {
+		t.Fatalf("got %v, want a new transaction", moved)
+	}
+	waitOrderStatus(t, service, response.TransactionId, "cancelled")
+	waitOrderStatus(t, service, moved.TransactionId, "active")
+	if order, _ := service.orderTracker.Order(moved.TransactionId); order.Price.String() != "2" {
+		t.Fatalf("moved to %s, want 2", order.Price)
+	}
+
+	tests := []struct {
+		name      string
+		request   *server2.MoveOrderRequest
+		rejection rejectionCase
+	}{
+		{
+			name:    "price not a decimal",
+			request: &server2.MoveOrderRequest{TransactionId: moved.TransactionId, Price: "two"},
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "price",
+			},
+		},
+		{
+			name: "quantity required",
+			request: &server2.MoveOrderRequest{
+				TransactionId: moved.TransactionId,
+				Price:         "3.00",
+				MoveFlag:      server2.MoveFlag_MOVE_FLAG_SET_QUANTITY,
+			},
+			rejection: rejectionCase{
+				code:   codes.InvalidArgument,
+				reason: server2.OrderRejectionReason_ORDER_REJECTION_REASON_INVALID,
+				field:  "quantity",
+			},
+		},
+		{
+			name:    "unknown order",
+			request: &server2.MoveOrderRequest{TransactionId: response.TransactionId, Price: "3.00"},
+			rejection: rejectionCase{
+				code:    codes.FailedPrecondition,
+				reason:  server2.OrderRejectionReason_ORDER_REJECTION_REASON_REJECTED,
+				message: "not found",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := service.MoveOrder(context.Background(), test.request)
+			expectRejection(t, err, test.rejection)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultSearchLimit = 50
+
+func (s *ConnectService) ListSecurities(_ context.Context, request *server2.ListSecuritiesRequest) (*server2.ListSecuritiesResponse, error) {
+	securities := s.catalog.Securities(securityQuery(request.Markets, request.Boards, request.Sectypes))
+
+	response := &server2.ListSecuritiesResponse{
+		Securities: make([]*server2.Security, 0, len(securities)),
+	}
+	for i := range securities {
+		response.Securities = append(response.Securities, toProtoSecurity(&securities[i]))
+	}
+	for _, market := range s.catalog.Markets() {
+		response.Markets = append(response.Markets, &server2.Market{Id: int32(market.Id), Name: market.Name})
+	}
+	for _, board := range s.catalog.Boards() {
+		response.Boards = append(response.Boards, &server2.Board{
+			Id:     board.Id,
+			Name:   board.Name,
+			Market: int32(board.Market),
+			Type:   int32(board.Type),
+		})
+	}
+	for _, kind := range s.catalog.CandleKinds() {
+		response.CandleKinds = append(response.CandleKinds, &server2.CandleKind{
+			Id:     int32(kind.Id),
+			Period: int32(kind.Period),
+			Name:   kind.Name,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *ConnectService) GetSecurity(_ context.Context, request *server2.GetSecurityRequest) (*server2.Security, error) {
+	var security transaq.CatalogSecurity
+	var ok bool
+
+	switch {
+	case request.Secid != 0:
+		security, ok = s.catalog.SecurityById(int(request.Secid))
+	case request.Board != "" && request.Seccode != "":
+		security, ok = s.catalog.Security(commands.SecurityRef{Board: request.Board, SecCode: request.Seccode})
+	default:
+		return nil, status.Error(codes.InvalidArgument, "secid or board and seccode are required")
+	}
+
+	if !ok {
+		return nil, status.Error(codes.NotFound, "security is not in the catalog")
+	}
+
+	return toProtoSecurity(&security), nil
+}
+
+func (s *ConnectService) SearchSecurities(_ context.Context, request *server2.SearchSecuritiesRequest) (*server2.SearchSecuritiesResponse, error) {
+	if request.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	limit := int(request.Limit)
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	securities := s.catalog.Search(request.Query, securityQuery(request.Markets, request.Boards, request.Sectypes), limit)
+
+	response := &server2.SearchSecuritiesResponse{
+		Securities: make([]*server2.Security, 0, len(securities)),
+	}
+	for i := range securities {
+		response.Securities = append(response.Securities, toProtoSecurity(&securities[i]))
+	}
+
+	return response, nil
+}
+
+func securityQuery(markets []int32, boards []string, secTypes []string) transaq.SecurityQuery {
+	query := transaq.SecurityQuery{Boards: boards, SecTypes: secTypes}
+	for _, market := range markets {
+		query.Markets = append(query.Markets, int(market))
+	}
+
+	return query
+}
+
+func toProtoSecurity(security *transaq.CatalogSecurity) *server2.Security {
+	return &server2.Security{
+		Secid:      int32(security.SecId),
+		Active:     security.Active,
+		Seccode:    security.SecCode,
+		Instrclass: security.InstrClass,
+		Board:      security.Board,
+		Market:     int32(security.Market),
+		Currency:   security.Currency,
+		Shortname:  security.ShortName,
+		Decimals:   int32(security.Decimals),
+		Minstep:    security.MinStep.String(),
+		Lotsize:    security.LotSize,
+		Lotdivider: security.LotDivider,
+		PointCost:  security.PointCost.String(),
+		UseCredit:  bool(security.OpMask.UseCredit),
+		ByMarket:   bool(security.OpMask.ByMarket),
+		NoSplit:    bool(security.OpMask.NoSplit),
+		Fok:        bool(security.OpMask.Fok),
+		Ioc:        bool(security.OpMask.Ioc),
+		Sectype:    security.SecType,
+		SecTz:      security.SecTz,
+		Quotestype: int32(security.QuotesType),
+		Mic:        security.Mic,
+		Name:       security.Name,
+		MinPrice:   decimalString(security.MinPrice),
+		MaxPrice:   decimalString(security.MaxPrice),
+		BgoC:       decimalString(security.BgoC),
+		BgoNc:      decimalString(security.BgoNc),
+		BgoBuy:     decimalString(security.BgoBuy),
+	}
+}
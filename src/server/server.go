@@ -1,21 +1,36 @@
-//go:build windows && amd64
-
 package server
 
-import "C"
 import (
 	"context"
+	"errors"
 	"github.com/TrueGameover/transaq-grpc/src/client"
 	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
 	"github.com/TrueGameover/transaq-grpc/src/queue"
 	"github.com/TrueGameover/transaq-grpc/src/transaq"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync/atomic"
 	"time"
 )
 
+const (
+	defaultBlockTimeout = time.Second
+	// how long a blocking client may stay behind before it loses messages
+	maxBlockTimeout = time.Second * 5
+)
+
 func NewConnectService(
 	transaqHandler *transaq.TransaqHandler,
-	messagesQueue *queue.FixedQueue[string],
+	messagesQueue *queue.LanedQueue[transaq.Message],
+	messagesJournal *journal.Journal,
+	subscriptions *transaq.SubscriptionManager,
+	catalog *transaq.Catalog,
+	orderBooks *transaq.OrderBooks,
+	orderTracker *transaq.OrderTracker,
 	clientExists *client.ClientExists,
 	logger *zerolog.Logger,
 ) *ConnectService {
@@ -23,6 +38,11 @@ func NewConnectService(
 
 	return &ConnectService{
 		messagesQueue:  messagesQueue,
+		journal:        messagesJournal,
+		subscriptions:  subscriptions,
+		catalog:        catalog,
+		orderBooks:     orderBooks,
+		orderTracker:   orderTracker,
 		localLogger:    &serverLogger,
 		clientExists:   clientExists,
 		transaqHandler: transaqHandler,
@@ -34,30 +54,101 @@ type ConnectService struct {
 
 	localLogger    *zerolog.Logger
 	clientExists   *client.ClientExists
-	messagesCount  uint
 	transaqHandler *transaq.TransaqHandler
-	messagesQueue  *queue.FixedQueue[string]
+	messagesQueue  *queue.LanedQueue[transaq.Message]
+	// nil when the journal is disabled
+	journal       *journal.Journal
+	subscriptions *transaq.SubscriptionManager
+	catalog       *transaq.Catalog
+	orderBooks    *transaq.OrderBooks
+	orderTracker  *transaq.OrderTracker
+	// typed streams opened so far, names their subscriptions
+	streamsCount uint64
 }
 
 func (s *ConnectService) SendCommand(_ context.Context, request *server2.SendCommandRequest) (*server2.SendCommandResponse, error) {
-	msg, code, err := s.transaqHandler.SendCommand(request.Message)
+	result, err := s.transaqHandler.Execute(request.Message)
+	if result == nil {
+		s.localLogger.Error().Err(err).Msg("Command failed")
+		return nil, statusError(err, nil)
+	}
+
+	resp := &server2.SendCommandResponse{
+		Message:       result.Raw,
+		Code:          result.Errno,
+		Success:       result.Success,
+		TransactionId: result.TransactionId,
+		Error:         result.Message,
+	}
+
 	if err != nil {
-		s.localLogger.Error().Err(err)
-		return nil, err
+		s.localLogger.Warn().Err(err).Msg("Command rejected")
+		return nil, statusError(err, resp)
 	}
 
-	return &server2.SendCommandResponse{
-		Message: msg,
-		Code:    code,
-	}, nil
+	return resp, nil
 }
 
-func (s *ConnectService) FetchResponseData(_ *server2.DataRequest, srv server2.ConnectService_FetchResponseDataServer) error {
-	s.messagesCount = 0
+func (s *ConnectService) FetchResponseData(request *server2.DataRequest, srv server2.ConnectService_FetchResponseDataServer) error {
+	options, err := subscribeOptions(request)
+	if err != nil {
+		return statusError(err, nil)
+	}
+	filter, err := messageFilter(request.Filter)
+	if err != nil {
+		return err
+	}
+	if request.ResumeFromSequence > 0 && s.journal == nil {
+		return status.Error(codes.FailedPrecondition, "resume_from_sequence requires the journal")
+	}
+
 	s.clientExists.Connected()
-	s.localLogger.Info().Msg("Client connected")
+	s.localLogger.Info().Msgf("Client connected, slow consumer policy %s", request.SlowConsumerPolicy)
+
+	if request.SubscriberId != "" {
+		s.subscriptions.Attach(request.SubscriberId)
+		defer s.subscriptions.Detach(request.SubscriberId)
+	}
 
 	ctx := srv.Context()
+	subscription := s.messagesQueue.Subscribe(options)
+	defer subscription.Close()
+	// every message received later comes from the subscription
+	watermark := newDeliveryWatermark(s.transaqHandler.Sequence())
+
+	// the subscription has everything received from now on, the older messages
+	// are replayed from the journal and skipped when they come from the queue
+	var replayedUntil uint64
+	if request.ResumeFromSequence > 0 {
+		replayedUntil, err = s.resume(request.ResumeFromSequence, filter, srv)
+		if err != nil {
+			s.localLogger.Warn().Err(err).Msgf("Resume from %d failed", request.ResumeFromSequence)
+			s.clientExists.Disconnected()
+			return statusError(err, nil)
+		}
+		if replayedUntil > watermark.through {
+			watermark.through = replayedUntil
+		}
+	}
+
+	// the snapshot is taken after the subscription too, the tracked messages it
+	// holds are skipped when they come from the queue
+	var snapshotUntil uint64
+	state := s.transaqHandler.SessionState()
+	if request.ResumeFromSequence == 0 && !request.SkipSnapshot {
+		snapshotUntil, err = s.sendSnapshot(filter, watermark.through, srv)
+		if err != nil {
+			s.localLogger.Warn().Err(err).Msg("Snapshot sending failed")
+			s.clientExists.Disconnected()
+			return statusError(err, nil)
+		}
+	}
+
+	var source messageSource = subscription
+	if request.Conflate {
+		source = newConflatingSource(subscription, watermark)
+	}
+	var messagesCount uint64
 
 	go func() {
 		for {
@@ -65,8 +156,10 @@ func (s *ConnectService) FetchResponseData(_ *server2.DataRequest, srv server2.C
 
 			select {
 			case <-timeoutCtx.Done():
-				s.localLogger.Info().Msgf("Statistic: %d per minute", s.messagesCount)
-				s.messagesCount = 0
+				s.localLogger.Info().Msgf(
+					"Statistic: %d per minute, lag %d, missed %d",
+					atomic.SwapUint64(&messagesCount, 0), subscription.Lag(), subscription.Missed(),
+				)
 
 			case <-ctx.Done():
 				cancel()
@@ -77,28 +170,159 @@ func (s *ConnectService) FetchResponseData(_ *server2.DataRequest, srv server2.C
 		}
 	}()
 
-	messagesChannel := s.messagesQueue.Fetch(ctx)
-
 	for {
-		select {
-		case msg, ok := <-messagesChannel:
-			if !ok {
-				s.localLogger.Error().Msg("messagesChannel was closed")
-				return nil
-			}
+		item, err := source.Next(ctx)
+		if errors.Is(err, queue.ErrSlowConsumer) {
+			s.localLogger.Warn().Msgf("Client is too slow, disconnecting with lag %d", subscription.Lag())
+			s.clientExists.Disconnected()
+			return statusError(err, nil)
+		}
+		if err != nil {
+			s.localLogger.Warn().Msgf("Loop done %s", err)
+			s.clientExists.Disconnected()
+			// do not send disconnect command to transaq
+			return nil
+		}
+
+		if item.Missed > 0 {
+			s.localLogger.Warn().Msgf("Client is too slow, %d messages missed before %d", item.Missed, item.Value.Sequence)
 
-			resp := server2.DataResponse{Message: msg}
-			err := srv.Send(&resp)
+			err = srv.Send(&server2.DataResponse{Gap: &server2.Gap{Missed: item.Missed}, DeliveredThrough: watermark.through})
 			if err != nil {
 				s.localLogger.Error().Err(err).Msg("Sending error")
 			}
-			s.messagesCount++
+		}
+		if !request.Conflate {
+			// the conflating source delivers the messages merged into the item
+			watermark.deliver(item.Value.Sequence)
+		}
+		if item.Value.Sequence <= replayedUntil {
+			continue
+		}
+		if item.Value.Sequence <= snapshotUntil && state.Tracks(item.Value.Type) {
+			continue
+		}
+		message, ok := filterMessage(filter, item.Value)
+		if !ok {
+			continue
+		}
 
-		case <-ctx.Done():
-			s.localLogger.Warn().Msgf("Loop done %s", ctx.Err())
-			s.clientExists.Disconnected()
-			// do not send disconnect command to transaq
-			return nil
+		response := dataResponse(message)
+		response.DeliveredThrough = watermark.through
+		err = srv.Send(response)
+		if err != nil {
+			s.localLogger.Error().Err(err).Msg("Sending error")
+		}
+		atomic.AddUint64(&messagesCount, 1)
+	}
+}
+
+// resume sends the journaled messages starting with from and returns the last
+// sequence replayed.
+func (s *ConnectService) resume(
+	from uint64,
+	filter *messages.Filter,
+	srv server2.ConnectService_FetchResponseDataServer,
+) (uint64, error) {
+	last := from - 1
+	var count uint64
+
+	err := s.journal.Replay(from, 0, func(record journal.Record) error {
+		message, ok := filterMessage(filter, transaq.MessageFromRecord(record))
+		if ok {
+			response := dataResponse(message)
+			response.DeliveredThrough = record.Sequence
+			err := srv.Send(response)
+			if err != nil {
+				return err
+			}
+			count++
+		}
+
+		last = record.Sequence
+		return nil
+	})
+	if err != nil {
+		return last, err
+	}
+
+	s.localLogger.Info().Msgf("Client resumed from %d, %d messages replayed", from, count)
+
+	return last, nil
+}
+
+// sendSnapshot sends the session state followed by the marker and returns the
+// last sequence the snapshot holds.
+func (s *ConnectService) sendSnapshot(
+	filter *messages.Filter,
+	deliveredThrough uint64,
+	srv server2.ConnectService_FetchResponseDataServer,
+) (uint64, error) {
+	snapshot, sequence := s.transaqHandler.SessionState().Snapshot()
+	var count uint32
+
+	for _, message := range snapshot {
+		message, ok := filterMessage(filter, message)
+		if !ok {
+			continue
+		}
+
+		response := dataResponse(message)
+		response.Snapshot = true
+		response.DeliveredThrough = deliveredThrough
+		err := srv.Send(response)
+		if err != nil {
+			return sequence, err
 		}
+		count++
+	}
+
+	err := srv.Send(&server2.DataResponse{
+		SnapshotEnd:      &server2.SnapshotEnd{Sequence: sequence, Messages: count},
+		DeliveredThrough: deliveredThrough,
+	})
+	if err != nil {
+		return sequence, err
 	}
+
+	s.localLogger.Info().Msgf("Client got a snapshot of %d messages up to %d", count, sequence)
+
+	return sequence, nil
+}
+
+func dataResponse(message transaq.Message) *server2.DataResponse {
+	return &server2.DataResponse{
+		Message:    message.Data,
+		Sequence:   message.Sequence,
+		ReceivedAt: timestamppb.New(message.Received),
+		Type:       message.Type,
+	}
+}
+
+func subscribeOptions(request *server2.DataRequest) (queue.SubscribeOptions, error) {
+	options := queue.SubscribeOptions{
+		MaxLag: int(request.MaxLag),
+	}
+
+	switch request.SlowConsumerPolicy {
+	case server2.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_OLDEST:
+		options.Policy = queue.DropOldest
+	case server2.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DROP_NEWEST:
+		options.Policy = queue.DropNewest
+	case server2.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_BLOCK:
+		options.Policy = queue.Block
+		options.BlockTimeout = defaultBlockTimeout
+		if request.BlockTimeout != nil {
+			options.BlockTimeout = request.BlockTimeout.AsDuration()
+		}
+		if options.BlockTimeout <= 0 || options.BlockTimeout > maxBlockTimeout {
+			return options, status.Errorf(codes.InvalidArgument, "block_timeout must be in range (0, %s]", maxBlockTimeout)
+		}
+	case server2.SlowConsumerPolicy_SLOW_CONSUMER_POLICY_DISCONNECT:
+		options.Policy = queue.Disconnect
+	default:
+		return options, status.Errorf(codes.InvalidArgument, "unknown slow consumer policy %d", request.SlowConsumerPolicy)
+	}
+
+	return options, nil
 }
@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/client"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"testing"
+	"time"
+)
+
+// testConnector hands the messages of a test to the handler callback. Commands
+// are accepted and passed to sent when it is set, rejected otherwise.
+type testConnector struct {
+	callback transaq.MessageCallback
+	sent     chan string
+}
+
+func (c *testConnector) Init(context.Context) error { return nil }
+
+func (c *testConnector) IsInited() bool { return true }
+
+func (c *testConnector) SetCallback(callback transaq.MessageCallback) { c.callback = callback }
+
+func (c *testConnector) SendCommand(msg string) (string, uint64, error) {
+	if c.sent == nil {
+		return "", 0, errors.New("commands are not supported")
+	}
+
+	c.sent <- msg
+	return `<result success="true"/>`, 0, nil
+}
+
+func (c *testConnector) SetLogLevel(transaq.LogLevel) error { return nil }
+
+func (c *testConnector) Release() {}
+
+// testStream passes every response to the test, Send waits until it is taken.
+type testStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *server2.DataResponse
+	// the sequences Send started to wait with
+	sending chan uint64
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) Send(response *server2.DataResponse) error {
+	select {
+	case s.sending <- response.Sequence:
+	default:
+	}
+
+	select {
+	case s.responses <- response:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func newTestService(t *testing.T) (*ConnectService, *testConnector) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	messagesJournal, err := journal.NewJournal(&logger, journal.Options{Directory: t.TempDir(), SegmentSize: 1 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = messagesJournal.Close()
+	})
+
+	connector := &testConnector{}
+	messagesQueue := queue.NewLanedQueue[transaq.Message](100, 100)
+	handler := transaq.NewTransaqHandler(&logger, connector, messagesQueue, messagesJournal)
+	err = handler.Init(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewConnectService(
+		handler,
+		messagesQueue,
+		messagesJournal,
+		nil,
+		nil,
+		nil,
+		transaq.NewOrderTracker(&logger, handler),
+		client.NewClientExists(),
+		&logger,
+	)
+
+	return service, connector
+}
+
+func startStream(t *testing.T, service *ConnectService, request *server2.DataRequest) (*testStream, func()) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testStream{ctx: ctx, responses: make(chan *server2.DataResponse), sending: make(chan uint64, 100)}
+
+	done := make(chan error)
+	go func() {
+		done <- service.FetchResponseData(request, stream)
+	}()
+
+	stop := func() {
+		cancel()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("stream failed: %s", err)
+			}
+		case <-time.After(time.Second):
+			t.Error("stream is not stopped")
+		}
+	}
+	t.Cleanup(cancel)
+
+	return stream, stop
+}
+
+func receive(t *testing.T, stream *testStream) *server2.DataResponse {
+	t.Helper()
+
+	select {
+	case response := <-stream.responses:
+		return response
+	case <-time.After(time.Second):
+		t.Fatal("no response")
+		return nil
+	}
+}
+
+func waitSending(t *testing.T, stream *testStream, sequence uint64) {
+	t.Helper()
+
+	for {
+		select {
+		case sending := <-stream.sending:
+			if sending == sequence {
+				return
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%d is not sent", sequence)
+		}
+	}
+}
+
+type testResponse struct {
+	sequence         uint64
+	deliveredThrough uint64
+}
+
+func receiveMessages(t *testing.T, stream *testStream, count int) []testResponse {
+	t.Helper()
+
+	var responses []testResponse
+	for len(responses) < count {
+		response := receive(t, stream)
+		if response.Message == "" {
+			t.Fatalf("got %v, want a message", response)
+		}
+		responses = append(responses, testResponse{response.Sequence, response.DeliveredThrough})
+	}
+
+	return responses
+}
+
+func quotation(last int) string {
+	return fmt.Sprintf(`<quotations><quotation secid="1"><last>%d</last></quotation></quotations>`, last)
+}
+
+func TestFetchResponseDataResumesBehindReorderedMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		conflate bool
+		// the responses following the order
+		rest []testResponse
+	}{
+		{
+			name: "lanes",
+			rest: []testResponse{{3, 4}, {5, 5}},
+		},
+		{
+			name:     "lanes and conflation",
+			conflate: true,
+			// 3 is merged into 5
+			rest: []testResponse{{5, 5}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, connector := newTestService(t)
+
+			stream, stop := startStream(t, service, &server2.DataRequest{Conflate: test.conflate})
+			if response := receive(t, stream); response.SnapshotEnd == nil {
+				t.Fatalf("got %v, want snapshot end", response)
+			}
+
+			connector.callback(quotation(1))
+			got := receiveMessages(t, stream, 1)
+
+			// the stream waits in Send with 2 while 3, the order and 5 are queued,
+			// the order overtakes 3
+			connector.callback(quotation(2))
+			waitSending(t, stream, 2)
+			connector.callback(quotation(3))
+			connector.callback(`<orders><order transactionid="1"><status>matched</status></order></orders>`)
+			connector.callback(quotation(5))
+
+			got = append(got, receiveMessages(t, stream, 2+len(test.rest))...)
+			want := append([]testResponse{{1, 1}, {2, 2}, {4, 2}}, test.rest...)
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+			stop()
+
+			// the client got the order and dropped, 3 is not lost on resume
+			resumed, stopResumed := startStream(t, service, &server2.DataRequest{
+				Conflate:           test.conflate,
+				ResumeFromSequence: got[2].deliveredThrough + 1,
+			})
+			defer stopResumed()
+
+			got = receiveMessages(t, resumed, 3)
+			want = []testResponse{{3, 3}, {4, 4}, {5, 5}}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Fatalf("resumed with %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFetchResponseDataSkipsSnapshotDuplicates(t *testing.T) {
+	service, connector := newTestService(t)
+
+	// received before the stream, nobody has taken them from the queue yet
+	connector.callback(`<securities><security secid="1"><seccode>SBER</seccode></security></securities>`)
+	connector.callback(quotation(2))
+	connector.callback(`<orders><order transactionid="1"><status>active</status></order></orders>`)
+
+	stream, stop := startStream(t, service, &server2.DataRequest{})
+	defer stop()
+
+	var snapshot []string
+	for {
+		response := receive(t, stream)
+		if response.SnapshotEnd != nil {
+			if response.SnapshotEnd.Sequence != 3 || response.SnapshotEnd.Messages != 2 {
+				t.Fatalf("got %v, want the snapshot up to 3 of 2 messages", response.SnapshotEnd)
+			}
+			break
+		}
+		if !response.Snapshot {
+			t.Fatalf("got %v before the snapshot end", response)
+		}
+		snapshot = append(snapshot, response.Type)
+	}
+	if fmt.Sprint(snapshot) != "[securities orders]" {
+		t.Fatalf("the snapshot holds %v", snapshot)
+	}
+
+	// the queued securities and order are in the snapshot, the quotation is not,
+	// the skipped order still counts as delivered
+	connector.callback(`<orders><order transactionid="1"><status>matched</status></order></orders>`)
+
+	got := receiveMessages(t, stream, 2)
+	want := []testResponse{{2, 3}, {4, 4}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
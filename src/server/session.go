@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const serverStatusRefreshTimeout = time.Second * 5
+
+func (s *ConnectService) Connect(_ context.Context, request *server2.ConnectRequest) (*server2.ConnectResponse, error) {
+	command := commands.Connect{
+		Login:          request.Login,
+		Password:       request.Password,
+		Host:           request.Host,
+		Port:           int(request.Port),
+		Language:       request.Language,
+		AutoPos:        request.Autopos,
+		MicexRegisters: request.MicexRegisters,
+		Milliseconds:   request.Milliseconds,
+		UtcTime:        request.UtcTime,
+		RqDelay:        int(request.Rqdelay),
+		SessionTimeout: int(request.SessionTimeout),
+		RequestTimeout: int(request.RequestTimeout),
+		PushULimits:    int(request.PushULimits),
+		PushPosEquity:  int(request.PushPosEquity),
+	}
+
+	if request.Proxy != nil {
+		command.Proxy = &commands.Proxy{
+			Type:     commands.ProxyType(request.Proxy.Type),
+			Addr:     request.Proxy.Addr,
+			Port:     int(request.Proxy.Port),
+			Login:    request.Proxy.Login,
+			Password: request.Proxy.Password,
+		}
+	}
+
+	_, err := s.transaqHandler.Send(command)
+	if err != nil {
+		s.localLogger.Error().Err(err).Msgf("Connect to %s:%d failed", request.Host, request.Port)
+		return nil, statusError(err, nil)
+	}
+
+	return &server2.ConnectResponse{}, nil
+}
+
+func (s *ConnectService) Disconnect(_ context.Context, _ *server2.DisconnectRequest) (*server2.DisconnectResponse, error) {
+	_, err := s.transaqHandler.Send(commands.Disconnect{})
+	if err != nil {
+		s.localLogger.Error().Err(err).Msg("Disconnect failed")
+		return nil, statusError(err, nil)
+	}
+
+	return &server2.DisconnectResponse{}, nil
+}
+
+func (s *ConnectService) GetServerStatus(ctx context.Context, request *server2.ServerStatusRequest) (*server2.ConnectionStatus, error) {
+	if !request.Refresh {
+		return toProtoConnectionStatus(s.transaqHandler.ConnectionStatus()), nil
+	}
+
+	refreshCtx, cancel := context.WithTimeout(ctx, serverStatusRefreshTimeout)
+	defer cancel()
+
+	connectionStatus, err := s.transaqHandler.RefreshServerStatus(refreshCtx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, status.Error(codes.DeadlineExceeded, "server_status was not received")
+	}
+	if err != nil {
+		return nil, statusError(err, nil)
+	}
+
+	return toProtoConnectionStatus(connectionStatus), nil
+}
+
+func (s *ConnectService) WatchConnectionState(
+	_ *server2.WatchConnectionStateRequest,
+	srv server2.ConnectService_WatchConnectionStateServer,
+) error {
+	statuses := s.transaqHandler.WatchConnectionStatus(srv.Context())
+
+	for connectionStatus := range statuses {
+		err := srv.Send(toProtoConnectionStatus(connectionStatus))
+		if err != nil {
+			s.localLogger.Error().Err(err).Msg("Sending error")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toProtoConnectionStatus(connectionStatus transaq.ConnectionStatus) *server2.ConnectionStatus {
+	result := &server2.ConnectionStatus{
+		Error:    connectionStatus.Error,
+		ServerId: int32(connectionStatus.ServerId),
+		ServerTz: connectionStatus.ServerTz,
+	}
+
+	if !connectionStatus.Time.IsZero() {
+		result.Time = timestamppb.New(connectionStatus.Time)
+	}
+
+	result.State = toProtoConnectionState(connectionStatus.State)
+	result.PreviousState = toProtoConnectionState(connectionStatus.Previous)
+
+	return result
+}
+
+func toProtoConnectionState(state transaq.ConnectionState) server2.ConnectionState {
+	switch state {
+	case transaq.ConnectionConnected:
+		return server2.ConnectionState_CONNECTION_STATE_CONNECTED
+	case transaq.ConnectionRecovering:
+		return server2.ConnectionState_CONNECTION_STATE_RECOVERING
+	case transaq.ConnectionDisconnected:
+		return server2.ConnectionState_CONNECTION_STATE_DISCONNECTED
+	case transaq.ConnectionError:
+		return server2.ConnectionState_CONNECTION_STATE_ERROR
+	case transaq.ConnectionUninitialized:
+		return server2.ConnectionState_CONNECTION_STATE_UNINITIALIZED
+	case transaq.ConnectionConnecting:
+		return server2.ConnectionState_CONNECTION_STATE_CONNECTING
+	case transaq.ConnectionDisconnecting:
+		return server2.ConnectionState_CONNECTION_STATE_DISCONNECTING
+	}
+
+	return server2.ConnectionState_CONNECTION_STATE_UNKNOWN
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetServerStatusRefreshRequiresSession(t *testing.T) {
+	service, connector := newTestService(t)
+	connector.sent = make(chan string, 10)
+
+	_, err := service.GetServerStatus(context.Background(), &server2.ServerStatusRequest{Refresh: true})
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Fatalf("got %v, want %s", err, codes.FailedPrecondition)
+	}
+	if len(connector.sent) != 0 {
+		t.Fatalf("%s is sent while disconnected", <-connector.sent)
+	}
+}
+
+func TestGetServerStatusRefreshWaitsForServerStatus(t *testing.T) {
+	service, connector := newTestService(t)
+	connector.sent = make(chan string, 10)
+	connector.callback(`<server_status id="1" connected="true"/>`)
+
+	type answer struct {
+		status *server2.ConnectionStatus
+		err    error
+	}
+	answers := make(chan answer)
+	go func() {
+		connectionStatus, err := service.GetServerStatus(context.Background(), &server2.ServerStatusRequest{Refresh: true})
+		answers <- answer{connectionStatus, err}
+	}()
+
+	select {
+	case msg := <-connector.sent:
+		if !strings.Contains(msg, `"server_status"`) {
+			t.Fatalf("%s is sent, want server_status", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("server_status is not sent")
+	}
+
+	// the disconnecting transition of another client is not the answer
+	_, err := service.Disconnect(context.Background(), &server2.DisconnectRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connector.callback(`<server_status id="7" connected="false"/>`)
+
+	select {
+	case got := <-answers:
+		if got.err != nil {
+			t.Fatal(got.err)
+		}
+		if got.status.State != server2.ConnectionState_CONNECTION_STATE_DISCONNECTED || got.status.ServerId != 7 {
+			t.Fatalf("got %v, want the server_status message", got.status)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no answer")
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	server2 "github.com/TrueGameover/transaq-grpc/src/grpc/server"
+	"github.com/TrueGameover/transaq-grpc/src/transaq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var marketDataKinds = map[server2.MarketDataKind]transaq.MarketDataKind{
+	server2.MarketDataKind_MARKET_DATA_KIND_ALL_TRADES: transaq.MarketDataAllTrades,
+	server2.MarketDataKind_MARKET_DATA_KIND_QUOTATIONS: transaq.MarketDataQuotations,
+	server2.MarketDataKind_MARKET_DATA_KIND_QUOTES:     transaq.MarketDataQuotes,
+}
+
+func (s *ConnectService) Subscribe(_ context.Context, request *server2.SubscribeRequest) (*server2.SubscribeResponse, error) {
+	streams, err := marketDataStreams(request.SubscriberId, request.Securities, request.Kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	held, err := s.subscriptions.Subscribe(request.SubscriberId, streams)
+	if err != nil {
+		s.localLogger.Error().Err(err).Msgf("Subscribe of %s failed", request.SubscriberId)
+		return nil, statusError(err, nil)
+	}
+
+	return &server2.SubscribeResponse{Subscriptions: toProtoSubscriptions(held)}, nil
+}
+
+func (s *ConnectService) Unsubscribe(_ context.Context, request *server2.UnsubscribeRequest) (*server2.UnsubscribeResponse, error) {
+	streams, err := marketDataStreams(request.SubscriberId, request.Securities, request.Kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	held, err := s.subscriptions.Unsubscribe(request.SubscriberId, streams)
+	if err != nil {
+		s.localLogger.Error().Err(err).Msgf("Unsubscribe of %s failed", request.SubscriberId)
+		return nil, statusError(err, nil)
+	}
+
+	return &server2.UnsubscribeResponse{Subscriptions: toProtoSubscriptions(held)}, nil
+}
+
+func marketDataStreams(
+	subscriber string,
+	securities []*server2.SecurityRef,
+	kinds []server2.MarketDataKind,
+) ([]transaq.MarketDataStream, error) {
+	if subscriber == "" {
+		return nil, status.Error(codes.InvalidArgument, "subscriber_id is required")
+	}
+	if len(securities) == 0 || len(kinds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one security and one kind are required")
+	}
+
+	streams := make([]transaq.MarketDataStream, 0, len(securities)*len(kinds))
+	for _, kind := range kinds {
+		marketDataKind, ok := marketDataKinds[kind]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown market data kind %s", kind)
+		}
+
+		for _, security := range securities {
+			if security.Board == "" || security.Seccode == "" {
+				return nil, status.Error(codes.InvalidArgument, "security board and seccode are required")
+			}
+
+			streams = append(streams, transaq.MarketDataStream{
+				Kind:     marketDataKind,
+				Security: commands.SecurityRef{Board: security.Board, SecCode: security.Seccode},
+			})
+		}
+	}
+
+	return streams, nil
+}
+
+func toProtoSubscriptions(streams []transaq.MarketDataStream) []*server2.MarketDataSubscription {
+	subscriptions := make([]*server2.MarketDataSubscription, 0, len(streams))
+	for _, stream := range streams {
+		subscriptions = append(subscriptions, &server2.MarketDataSubscription{
+			Kind:     toProtoMarketDataKind(stream.Kind),
+			Security: &server2.SecurityRef{Board: stream.Security.Board, Seccode: stream.Security.SecCode},
+		})
+	}
+
+	return subscriptions
+}
+
+func toProtoMarketDataKind(kind transaq.MarketDataKind) server2.MarketDataKind {
+	for protoKind, marketDataKind := range marketDataKinds {
+		if marketDataKind == kind {
+			return protoKind
+		}
+	}
+
+	return server2.MarketDataKind_MARKET_DATA_KIND_UNSPECIFIED
+}
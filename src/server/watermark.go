@@ -0,0 +1,56 @@
+package server
+
+import "sort"
+
+// deliveryWatermark tracks the highest sequence every message up to which has
+// been sent to a stream or skipped. Trading events overtake market data and a
+// conflated update is sent in place of earlier ones, so the last sent sequence
+// is not a safe point to resume from.
+type deliveryWatermark struct {
+	// the sequence to resume after
+	through uint64
+	// delivered above through, sorted and never adjacent
+	ahead []sequenceRange
+}
+
+type sequenceRange struct {
+	first uint64
+	last  uint64
+}
+
+func newDeliveryWatermark(through uint64) *deliveryWatermark {
+	return &deliveryWatermark{through: through}
+}
+
+func (w *deliveryWatermark) deliver(sequence uint64) {
+	if sequence <= w.through {
+		return
+	}
+
+	// the first range that ends right before the sequence or later
+	i := sort.Search(len(w.ahead), func(i int) bool {
+		return w.ahead[i].last+1 >= sequence
+	})
+
+	switch {
+	case i == len(w.ahead) || w.ahead[i].first > sequence+1:
+		w.ahead = append(w.ahead, sequenceRange{})
+		copy(w.ahead[i+1:], w.ahead[i:])
+		w.ahead[i] = sequenceRange{first: sequence, last: sequence}
+
+	case sequence < w.ahead[i].first:
+		w.ahead[i].first = sequence
+
+	case sequence > w.ahead[i].last:
+		w.ahead[i].last = sequence
+		if i+1 < len(w.ahead) && w.ahead[i+1].first == sequence+1 {
+			w.ahead[i].last = w.ahead[i+1].last
+			w.ahead = append(w.ahead[:i+1], w.ahead[i+2:]...)
+		}
+	}
+
+	if w.ahead[0].first == w.through+1 {
+		w.through = w.ahead[0].last
+		w.ahead = w.ahead[1:]
+	}
+}
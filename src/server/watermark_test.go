@@ -0,0 +1,35 @@
+package server
+
+import "testing"
+
+func TestDeliveryWatermark(t *testing.T) {
+	tests := []struct {
+		name      string
+		delivered []uint64
+		through   uint64
+		ahead     int
+	}{
+		{name: "in order", delivered: []uint64{11, 12, 13}, through: 13},
+		{name: "below the start", delivered: []uint64{5, 10, 11}, through: 11},
+		{name: "overtaken", delivered: []uint64{13, 12}, through: 10, ahead: 1},
+		{name: "filled", delivered: []uint64{13, 12, 11}, through: 13},
+		{name: "repeated", delivered: []uint64{13, 13, 11, 11}, through: 11, ahead: 1},
+		{name: "ranges joined", delivered: []uint64{12, 16, 14, 13, 15, 11}, through: 16},
+		{name: "ranges apart", delivered: []uint64{20, 12, 16, 14}, through: 10, ahead: 4},
+		{name: "lost held back", delivered: []uint64{11, 13, 14, 15}, through: 11, ahead: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			watermark := newDeliveryWatermark(10)
+			for _, sequence := range test.delivered {
+				watermark.deliver(sequence)
+			}
+
+			if watermark.through != test.through || len(watermark.ahead) != test.ahead {
+				t.Fatalf("through %d with %v ahead, want %d with %d ranges",
+					watermark.through, watermark.ahead, test.through, test.ahead)
+			}
+		})
+	}
+}
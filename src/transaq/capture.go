@@ -0,0 +1,167 @@
+package transaq
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/rs/zerolog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+)
+
+type CaptureKind string
+
+const (
+	CaptureMessage CaptureKind = "message"
+	CaptureCommand CaptureKind = "command"
+	CaptureResult  CaptureKind = "result"
+)
+
+type CaptureRecord struct {
+	Time time.Time   `json:"time"`
+	Kind CaptureKind `json:"kind"`
+	Data string      `json:"data"`
+	Code uint64      `json:"code,omitempty"`
+}
+
+var captureSecrets = regexp.MustCompile(`(<password>)[^<]*(</password>)|((?:oldpass|newpass|password)=")[^"]*(")`)
+
+// CaptureWriter appends records to a json lines file, one record per line.
+type CaptureWriter struct {
+	mutex  *sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	path   string
+}
+
+func NewCaptureWriter(directory string) (*CaptureWriter, error) {
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(directory, fmt.Sprintf("capture-%s.jsonl", time.Now().Format("20060102-150405")))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CaptureWriter{
+		mutex:  &sync.Mutex{},
+		file:   file,
+		writer: bufio.NewWriter(file),
+		path:   path,
+	}, nil
+}
+
+func (w *CaptureWriter) Path() string {
+	return w.path
+}
+
+func (w *CaptureWriter) Write(record CaptureRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	_, err = w.writer.Write(append(data, '\n'))
+	if err != nil {
+		return err
+	}
+
+	// flush every record, a capture is useless if the incident kills the process
+	return w.writer.Flush()
+}
+
+func (w *CaptureWriter) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	err := w.writer.Flush()
+	if err != nil {
+		_ = w.file.Close()
+		return err
+	}
+
+	return w.file.Close()
+}
+
+// RecordingConnector wraps another connector and writes every callback message
+// and every command with its result to the capture.
+type RecordingConnector struct {
+	connector   Connector
+	capture     *CaptureWriter
+	localLogger *zerolog.Logger
+}
+
+func NewRecordingConnector(logger *zerolog.Logger, connector Connector, capture *CaptureWriter) *RecordingConnector {
+	localLogger := logger.With().Str("Service", "RecordingConnector").Logger()
+
+	return &RecordingConnector{
+		connector:   connector,
+		capture:     capture,
+		localLogger: &localLogger,
+	}
+}
+
+func (c *RecordingConnector) Init(appContext context.Context) error {
+	c.localLogger.Info().Msgf("Recording transaq traffic to %s", c.capture.Path())
+
+	return c.connector.Init(appContext)
+}
+
+func (c *RecordingConnector) IsInited() bool {
+	return c.connector.IsInited()
+}
+
+func (c *RecordingConnector) SetCallback(callback MessageCallback) {
+	c.connector.SetCallback(func(msg string) {
+		c.write(CaptureRecord{Time: time.Now(), Kind: CaptureMessage, Data: msg})
+		callback(msg)
+	})
+}
+
+func (c *RecordingConnector) SendCommand(msg string) (string, uint64, error) {
+	c.write(CaptureRecord{Time: time.Now(), Kind: CaptureCommand, Data: redactCommand(msg)})
+
+	resp, code, err := c.connector.SendCommand(msg)
+	if err != nil {
+		c.write(CaptureRecord{Time: time.Now(), Kind: CaptureResult, Data: err.Error(), Code: code})
+		return resp, code, err
+	}
+
+	c.write(CaptureRecord{Time: time.Now(), Kind: CaptureResult, Data: resp, Code: code})
+
+	return resp, code, nil
+}
+
+func (c *RecordingConnector) SetLogLevel(level LogLevel) error {
+	return c.connector.SetLogLevel(level)
+}
+
+func (c *RecordingConnector) Release() {
+	c.connector.Release()
+
+	err := c.capture.Close()
+	if err != nil {
+		c.localLogger.Error().Err(err).Msg("capture close failed")
+	}
+}
+
+func (c *RecordingConnector) write(record CaptureRecord) {
+	err := c.capture.Write(record)
+	if err != nil {
+		c.localLogger.Error().Err(err).Msg("capture write failed")
+	}
+}
+
+func redactCommand(msg string) string {
+	return captureSecrets.ReplaceAllString(msg, "$1$3***$2$4")
+}
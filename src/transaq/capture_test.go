@@ -0,0 +1,223 @@
+package transaq
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// collector keeps the callback messages of a connector.
+type collector struct {
+	mutex    *sync.Mutex
+	messages []string
+}
+
+func (c *collector) callback(msg string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.messages = append(c.messages, msg)
+}
+
+func (c *collector) collected() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]string(nil), c.messages...)
+}
+
+func readCapture(t *testing.T, path string) []CaptureRecord {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var records []CaptureRecord
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		record := CaptureRecord{}
+		err = json.Unmarshal(scanner.Bytes(), &record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	if scanner.Err() != nil {
+		t.Fatal(scanner.Err())
+	}
+
+	return records
+}
+
+func TestCaptureReplayRoundTrip(t *testing.T) {
+	logger := zerolog.Nop()
+	capture, err := NewCaptureWriter(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorded := &collector{mutex: &sync.Mutex{}}
+	recording := NewRecordingConnector(&logger, NewSimulatorConnector(&logger), capture)
+	recording.SetCallback(recorded.callback)
+	err = recording.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	price := decimal.RequireFromString("300.00")
+	for _, command := range []commands.Command{
+		commands.Connect{Login: "login", Password: "secret", Host: "host", Port: 3900},
+		commands.NewOrder{
+			Security: commands.SecurityRef{Board: "TQBR", SecCode: "SBER"},
+			Client:   simulatorClientId,
+			Price:    &price,
+			Quantity: 1,
+			BuySell:  messages.Buy,
+		},
+		commands.ChangePass{OldPass: "secret", NewPass: "secret2"},
+	} {
+		msg, err := commands.Marshal(command)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = recording.SendCommand(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the reference data and server_status, the order, its trade and the fill
+	waitFor(t, "the callbacks", func() bool {
+		return len(recorded.collected()) == 10
+	})
+	recording.Release()
+
+	records := readCapture(t, capture.Path())
+	var kinds []CaptureKind
+	var captured []string
+	for _, record := range records {
+		if strings.Contains(record.Data, "secret") {
+			t.Fatalf("the capture holds a secret: %s", record.Data)
+		}
+		if record.Time.IsZero() {
+			t.Fatalf("%s has no time", record.Data)
+		}
+
+		switch record.Kind {
+		case CaptureMessage:
+			captured = append(captured, record.Data)
+		default:
+			kinds = append(kinds, record.Kind)
+		}
+	}
+	if fmt.Sprint(captured) != fmt.Sprint(recorded.collected()) {
+		t.Fatalf("captured\n%v\nwant\n%v", captured, recorded.collected())
+	}
+	// every command is followed by its result
+	if fmt.Sprint(kinds) != "[command result command result command result]" {
+		t.Fatalf("got %v", kinds)
+	}
+
+	replayed := &collector{mutex: &sync.Mutex{}}
+	replay, err := NewReplayConnector(&logger, capture.Path(), ReplaySpeedMax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replay.SetCallback(replayed.callback)
+	err = replay.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replay.Release()
+
+	waitFor(t, "the replay", func() bool {
+		return len(replayed.collected()) == len(captured)
+	})
+	if fmt.Sprint(replayed.collected()) != fmt.Sprint(captured) {
+		t.Fatalf("replayed\n%v\nwant\n%v", replayed.collected(), captured)
+	}
+
+	// commands are not executed
+	resp, _, err := replay.SendCommand(`<command id="disconnect"/>`)
+	if err != nil || resp != `<result success="true"/>` {
+		t.Fatalf("got %s %v", resp, err)
+	}
+}
+
+func TestReplayPacing(t *testing.T) {
+	logger := zerolog.Nop()
+	capture, err := NewCaptureWriter(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := time.Now()
+	for i, offset := range []time.Duration{0, time.Millisecond * 200, time.Millisecond * 400} {
+		err = capture.Write(CaptureRecord{Time: started.Add(offset), Kind: CaptureMessage, Data: fmt.Sprintf("<m%d/>", i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = capture.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// twice as fast, the last message comes 200ms after the first one
+	received := make(chan time.Time, 3)
+	replay, err := NewReplayConnector(&logger, capture.Path(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replay.SetCallback(func(string) {
+		received <- time.Now()
+	})
+	err = replay.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replay.Release()
+
+	var times []time.Time
+	for len(times) < 3 {
+		select {
+		case at := <-received:
+			times = append(times, at)
+		case <-time.After(time.Second):
+			t.Fatalf("%d messages replayed", len(times))
+		}
+	}
+
+	if elapsed := times[2].Sub(times[0]); elapsed < time.Millisecond*180 || elapsed > time.Millisecond*600 {
+		t.Fatalf("replayed in %s, want 200ms", elapsed)
+	}
+}
+
+func TestNewReplayConnectorInvalid(t *testing.T) {
+	logger := zerolog.Nop()
+
+	_, err := NewReplayConnector(&logger, "", 1)
+	if err == nil {
+		t.Fatal("an empty path is accepted")
+	}
+	_, err = NewReplayConnector(&logger, "capture.jsonl", -1)
+	if err == nil {
+		t.Fatal("a negative speed is accepted")
+	}
+}
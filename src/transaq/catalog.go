@@ -0,0 +1,445 @@
+package transaq
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/rs/zerolog"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// CatalogSecurity is a security with the changes of sec_info and sec_info_upd applied.
+type CatalogSecurity struct {
+	messages.Security
+	// full name from sec_info
+	Name     string
+	MinPrice *messages.Decimal
+	MaxPrice *messages.Decimal
+	BgoC     *messages.Decimal
+	BgoNc    *messages.Decimal
+	BgoBuy   *messages.Decimal
+}
+
+// SecurityQuery selects securities, every set field must match.
+type SecurityQuery struct {
+	Markets  []int
+	Boards   []string
+	SecTypes []string
+}
+
+func (q SecurityQuery) matches(security *CatalogSecurity) bool {
+	if len(q.Markets) > 0 && !containsInt(q.Markets, security.Market) {
+		return false
+	}
+	if len(q.Boards) > 0 && !containsString(q.Boards, security.Board) {
+		return false
+	}
+	if len(q.SecTypes) > 0 && !containsString(q.SecTypes, security.SecType) {
+		return false
+	}
+
+	return true
+}
+
+// Catalog keeps the reference data Transaq sends once after connect, so clients
+// that come later do not need a reconnect to get it. It is cleared when a client
+// disconnects, the next connect may go to another server. The data comes right
+// after connect, so a connect can not clear it without a race.
+type Catalog struct {
+	mutex       *sync.RWMutex
+	markets     map[int]messages.Market
+	boards      map[string]messages.Board
+	candleKinds map[int]messages.CandleKind
+	securities  map[int]*CatalogSecurity
+	// secid by board and seccode
+	secIds      map[commands.SecurityRef]int
+	localLogger *zerolog.Logger
+}
+
+func NewCatalog(logger *zerolog.Logger, handler *TransaqHandler) *Catalog {
+	localLogger := logger.With().Str("Service", "Catalog").Logger()
+
+	c := &Catalog{
+		mutex:       &sync.RWMutex{},
+		localLogger: &localLogger,
+	}
+	c.clear()
+
+	dispatcher := handler.Dispatcher()
+	messages.Handle(dispatcher, c.onMarkets)
+	messages.Handle(dispatcher, c.onBoards)
+	messages.Handle(dispatcher, c.onCandleKinds)
+	messages.Handle(dispatcher, c.onSecurities)
+	messages.Handle(dispatcher, c.onSecInfo)
+	messages.Handle(dispatcher, c.onSecInfoUpd)
+	handler.AddCommandObserver(c.observeCommand)
+
+	return c
+}
+
+// clear must be called with the mutex held.
+func (c *Catalog) clear() {
+	c.markets = map[int]messages.Market{}
+	c.boards = map[string]messages.Board{}
+	c.candleKinds = map[int]messages.CandleKind{}
+	c.securities = map[int]*CatalogSecurity{}
+	c.secIds = map[commands.SecurityRef]int{}
+}
+
+func (c *Catalog) observeCommand(command string, result *commands.Result) {
+	if result.Success && commandId(command) == "disconnect" {
+		c.mutex.Lock()
+		c.clear()
+		c.mutex.Unlock()
+
+		c.localLogger.Info().Msg("Catalog cleared after disconnect")
+	}
+}
+
+func (c *Catalog) onMarkets(msg *messages.Markets) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, market := range msg.Items {
+		c.markets[market.Id] = market
+	}
+}
+
+func (c *Catalog) onBoards(msg *messages.Boards) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, board := range msg.Items {
+		c.boards[board.Id] = board
+	}
+}
+
+func (c *Catalog) onCandleKinds(msg *messages.CandleKinds) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, kind := range msg.Items {
+		c.candleKinds[kind.Id] = kind
+	}
+}
+
+// onSecurities merges the message, the securities come in several parts and
+// a security sent again replaces the previous one keeping the sec_info data.
+func (c *Catalog) onSecurities(msg *messages.Securities) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, item := range msg.Items {
+		security, ok := c.securities[item.SecId]
+		if !ok {
+			security = &CatalogSecurity{}
+			c.securities[item.SecId] = security
+		} else {
+			delete(c.secIds, commands.SecurityRef{Board: security.Board, SecCode: security.SecCode})
+		}
+
+		security.Security = item
+		c.secIds[commands.SecurityRef{Board: item.Board, SecCode: item.SecCode}] = item.SecId
+	}
+
+	c.localLogger.Debug().Msgf("Catalog holds %d securities", len(c.securities))
+}
+
+func (c *Catalog) onSecInfo(msg *messages.SecInfo) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	security := c.find(msg.SecId, msg.SecCode, msg.Market)
+	if security == nil {
+		c.localLogger.Debug().Msgf("sec_info of unknown security %d %s", msg.SecId, msg.SecCode)
+		return
+	}
+
+	security.Name = msg.SecName
+	security.PointCost = msg.PointCost
+	security.MinPrice = decimalCopy(msg.MinPrice)
+	security.MaxPrice = decimalCopy(msg.MaxPrice)
+	security.BgoC = decimalCopy(msg.BgoC)
+	security.BgoNc = decimalCopy(msg.BgoNc)
+}
+
+func (c *Catalog) onSecInfoUpd(msg *messages.SecInfoUpd) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	security := c.find(msg.SecId, msg.SecCode, msg.Market)
+	if security == nil {
+		c.localLogger.Debug().Msgf("sec_info_upd of unknown security %d %s", msg.SecId, msg.SecCode)
+		return
+	}
+
+	if msg.PointCost != nil {
+		security.PointCost = *msg.PointCost
+	}
+	if msg.MinPrice != nil {
+		security.MinPrice = msg.MinPrice
+	}
+	if msg.MaxPrice != nil {
+		security.MaxPrice = msg.MaxPrice
+	}
+	if msg.BgoC != nil {
+		security.BgoC = msg.BgoC
+	}
+	if msg.BgoNc != nil {
+		security.BgoNc = msg.BgoNc
+	}
+	if msg.BgoBuy != nil {
+		security.BgoBuy = msg.BgoBuy
+	}
+}
+
+// find looks the security up by secid, then by seccode and market, must be
+// called with the mutex held.
+func (c *Catalog) find(secId int, secCode string, market int) *CatalogSecurity {
+	if security, ok := c.securities[secId]; ok && (secCode == "" || security.SecCode == secCode) {
+		return security
+	}
+	if secCode == "" {
+		return nil
+	}
+
+	for _, security := range c.securities {
+		if security.SecCode == secCode && security.Market == market {
+			return security
+		}
+	}
+
+	return nil
+}
+
+func (c *Catalog) Markets() []messages.Market {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	markets := make([]messages.Market, 0, len(c.markets))
+	for _, market := range c.markets {
+		markets = append(markets, market)
+	}
+	sort.Slice(markets, func(i, j int) bool {
+		return markets[i].Id < markets[j].Id
+	})
+
+	return markets
+}
+
+func (c *Catalog) Boards() []messages.Board {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	boards := make([]messages.Board, 0, len(c.boards))
+	for _, board := range c.boards {
+		boards = append(boards, board)
+	}
+	sort.Slice(boards, func(i, j int) bool {
+		return boards[i].Id < boards[j].Id
+	})
+
+	return boards
+}
+
+func (c *Catalog) CandleKinds() []messages.CandleKind {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	kinds := make([]messages.CandleKind, 0, len(c.candleKinds))
+	for _, kind := range c.candleKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].Id < kinds[j].Id
+	})
+
+	return kinds
+}
+
+// Securities returns the matching securities ordered by secid.
+func (c *Catalog) Securities(query SecurityQuery) []CatalogSecurity {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	var securities []CatalogSecurity
+	for _, security := range c.securities {
+		if query.matches(security) {
+			securities = append(securities, *security)
+		}
+	}
+	sort.Slice(securities, func(i, j int) bool {
+		return securities[i].SecId < securities[j].SecId
+	})
+
+	return securities
+}
+
+func (c *Catalog) SecurityById(secId int) (CatalogSecurity, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	security, ok := c.securities[secId]
+	if !ok {
+		return CatalogSecurity{}, false
+	}
+
+	return *security, true
+}
+
+func (c *Catalog) Security(ref commands.SecurityRef) (CatalogSecurity, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	secId, ok := c.secIds[ref]
+	if !ok {
+		return CatalogSecurity{}, false
+	}
+
+	return *c.securities[secId], true
+}
+
+// Search returns up to limit matching securities, the best matches first:
+// exact code, code prefix, name word prefix, substring and then the ones that
+// hold the query letters in order.
+func (c *Catalog) Search(text string, query SecurityQuery, limit int) []CatalogSecurity {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if text == "" || limit <= 0 {
+		return nil
+	}
+
+	type match struct {
+		security *CatalogSecurity
+		score    int
+	}
+
+	c.mutex.RLock()
+	var matches []match
+	for _, security := range c.securities {
+		if !query.matches(security) {
+			continue
+		}
+
+		score, ok := searchScore(security, text)
+		if ok {
+			matches = append(matches, match{security: security, score: score})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		if a.security.SecCode != b.security.SecCode {
+			return a.security.SecCode < b.security.SecCode
+		}
+		return a.security.Board < b.security.Board
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	securities := make([]CatalogSecurity, 0, len(matches))
+	for _, m := range matches {
+		securities = append(securities, *m.security)
+	}
+	c.mutex.RUnlock()
+
+	return securities
+}
+
+// searchScore is lower for a better match.
+func searchScore(security *CatalogSecurity, text string) (int, bool) {
+	code := strings.ToLower(security.SecCode)
+	names := []string{strings.ToLower(security.ShortName), strings.ToLower(security.Name)}
+
+	switch {
+	case code == text:
+		return 0, true
+	case strings.HasPrefix(code, text):
+		return 1, true
+	}
+
+	for _, name := range names {
+		for _, word := range strings.FieldsFunc(name, isSeparator) {
+			if strings.HasPrefix(word, text) {
+				return 2, true
+			}
+		}
+	}
+
+	if strings.Contains(code, text) {
+		return 3, true
+	}
+	for _, name := range names {
+		if strings.Contains(name, text) {
+			return 3, true
+		}
+	}
+
+	best, found := 0, false
+	for _, value := range append(names, code) {
+		gaps, ok := subsequenceGaps(value, text)
+		if ok && (!found || gaps < best) {
+			best, found = gaps, true
+		}
+	}
+
+	return 4 + best, found
+}
+
+func isSeparator(r rune) bool {
+	return r == ' ' || r == '-' || r == '.' || r == '"' || r == '(' || r == ')'
+}
+
+// subsequenceGaps counts the letters skipped between the letters of the text
+// found in the value in order.
+func subsequenceGaps(value string, text string) (int, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	gaps, started := 0, false
+	for _, r := range value {
+		if text == "" {
+			break
+		}
+
+		next, size := utf8.DecodeRuneInString(text)
+		if r == next {
+			text = text[size:]
+			started = true
+		} else if started {
+			gaps++
+		}
+	}
+
+	return gaps, text == ""
+}
+
+func decimalCopy(value messages.Decimal) *messages.Decimal {
+	return &value
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
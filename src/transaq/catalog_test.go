@@ -0,0 +1,132 @@
+package transaq
+
+import (
+	"context"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"testing"
+)
+
+func newTestCatalog(t *testing.T) (*TransaqHandler, *Catalog) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	handler := NewTransaqHandler(&logger, NewSimulatorConnector(&logger), queue.NewLanedQueue[Message](100, 100), nil)
+
+	return handler, NewCatalog(&logger, handler)
+}
+
+func securityEntity(secId int, board string, secCode string, shortName string) string {
+	return fmt.Sprintf(`<security secid="%d" active="true"><seccode>%s</seccode><board>%s</board>`+
+		`<market>1</market><shortname>%s</shortname><sectype>SHARE</sectype></security>`, secId, secCode, board, shortName)
+}
+
+func renderSecurities(securities []CatalogSecurity) []string {
+	rendered := make([]string, 0, len(securities))
+	for _, security := range securities {
+		rendered = append(rendered, security.Board+"/"+security.SecCode)
+	}
+
+	return rendered
+}
+
+func TestCatalogSearch(t *testing.T) {
+	handler, catalog := newTestCatalog(t)
+	handler.receiveData(`<securities>` +
+		securityEntity(1, "TQBR", "SBER", "Сбербанк") +
+		securityEntity(2, "TQBR", "SBERP", "Сбербанк-п") +
+		securityEntity(3, "TQBR", "GAZP", "ГАЗПРОМ ао") +
+		securityEntity(4, "TQBR", "ASBER", "Other") +
+		securityEntity(5, "TQBR", "SBXER", "Other") +
+		securityEntity(6, "TQBR", "SXBXEXR", "Other") +
+		securityEntity(7, "TQBR", "ABCD", "Acme Sberry") +
+		securityEntity(8, "TQBR", "QWER", "") +
+		securityEntity(9, "SMAL", "SBER", "Сбербанк") +
+		`</securities>`)
+	// the full name comes with sec_info
+	handler.receiveData(`<sec_info secid="8"><secname>The "Sberbank" holding</secname><seccode>QWER</seccode><market>1</market></sec_info>`)
+
+	tests := []struct {
+		name  string
+		text  string
+		query SecurityQuery
+		limit int
+		want  []string
+	}{
+		{
+			name:  "ranked",
+			text:  "sber",
+			limit: 10,
+			want: []string{
+				// exact code, the same code ordered by board
+				"SMAL/SBER", "TQBR/SBER",
+				// code prefix
+				"TQBR/SBERP",
+				// name word prefix, short or full
+				"TQBR/ABCD", "TQBR/QWER",
+				// substring
+				"TQBR/ASBER",
+				// in order with fewer letters between
+				"TQBR/SBXER", "TQBR/SXBXEXR",
+			},
+		},
+		{name: "case and spaces", text: " SbEr ", limit: 2, want: []string{"SMAL/SBER", "TQBR/SBER"}},
+		{name: "limited", text: "sber", limit: 3, want: []string{"SMAL/SBER", "TQBR/SBER", "TQBR/SBERP"}},
+		{name: "name word", text: "газ", limit: 10, want: []string{"TQBR/GAZP"}},
+		{name: "query", text: "sber", query: SecurityQuery{Boards: []string{"SMAL"}}, limit: 10, want: []string{"SMAL/SBER"}},
+		{name: "no match", text: "lkoh", limit: 10, want: []string{}},
+		{name: "zero limit", text: "sber", limit: 0, want: []string{}},
+		{name: "negative limit", text: "sber", limit: -1, want: []string{}},
+		{name: "empty text", text: " ", limit: 10, want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := renderSecurities(catalog.Search(test.text, test.query, test.limit))
+			if fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCatalogClearedOnDisconnect(t *testing.T) {
+	handler, catalog := newTestCatalog(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		handler.Release()
+	})
+	err := handler.Init(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = handler.Send(testConnect("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the securities", func() bool {
+		_, ok := catalog.Security(commands.SecurityRef{Board: "TQBR", SecCode: "SBER"})
+		return ok
+	})
+
+	_, err = handler.Send(commands.Disconnect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the next connect may go to another server
+	if securities := catalog.Securities(SecurityQuery{}); len(securities) != 0 {
+		t.Fatalf("got %v after disconnect", renderSecurities(securities))
+	}
+	if found := catalog.Search("sber", SecurityQuery{}, 10); len(found) != 0 {
+		t.Fatalf("found %v after disconnect", renderSecurities(found))
+	}
+	if len(catalog.Markets()) != 0 || len(catalog.Boards()) != 0 {
+		t.Fatal("the markets and boards are kept after disconnect")
+	}
+}
@@ -0,0 +1,209 @@
+//go:build windows && amd64
+
+package transaq
+
+//#include <stdlib.h>
+import "C"
+
+import (
+	"context"
+	"errors"
+	"github.com/rs/zerolog"
+	"golang.org/x/sys/windows"
+	"unsafe"
+)
+
+type DllConnector struct {
+	options          InitOptions
+	txmlconnector    *windows.DLL
+	procSetCallback  *windows.Proc
+	procSendCommand  *windows.Proc
+	procFreeMemory   *windows.Proc
+	procInitialize   *windows.Proc
+	procUnInitialize *windows.Proc
+	procSetLogLevel  *windows.Proc
+	forMemoryFree    chan *C.char
+	callback         MessageCallback
+	localLogger      *zerolog.Logger
+}
+
+func NewDllConnector(logger *zerolog.Logger, freeMemoryBufferSize int, options InitOptions) *DllConnector {
+	forMemoryFree := make(chan *C.char, freeMemoryBufferSize)
+	localLogger := logger.With().Str("Service", "DllConnector").Logger()
+
+	return &DllConnector{
+		options:       options,
+		forMemoryFree: forMemoryFree,
+		localLogger:   &localLogger,
+		callback:      func(string) {},
+	}
+}
+
+func (c *DllConnector) SetCallback(callback MessageCallback) {
+	c.callback = callback
+}
+
+func (c *DllConnector) IsInited() bool {
+	return c.txmlconnector != nil
+}
+
+func (c *DllConnector) Init(appContext context.Context) error {
+	initCommandStr, err := c.options.InitCommand()
+	if err != nil {
+		return err
+	}
+
+	dll, err := windows.LoadDLL(c.options.DllPath)
+	if err != windows.Errno(0) && err != nil {
+		c.localLogger.Error().Msgf("load dll failed %d", err)
+		return err
+	}
+
+	c.txmlconnector = dll
+	c.procSetCallback = c.txmlconnector.MustFindProc("SetCallback")
+	c.procSendCommand = c.txmlconnector.MustFindProc("SendCommand")
+	c.procFreeMemory = c.txmlconnector.MustFindProc("FreeMemory")
+	c.procInitialize = c.txmlconnector.MustFindProc("InitializeEx")
+	c.procUnInitialize = c.txmlconnector.MustFindProc("UnInitialize")
+	c.procSetLogLevel = c.txmlconnector.MustFindProc("SetLogLevel")
+
+	initCommandPtr := unsafe.Pointer(C.CString(initCommandStr))
+	defer C.free(initCommandPtr)
+	retVal, _, err := c.procInitialize.Call(uintptr(initCommandPtr))
+	if err != windows.Errno(0) {
+		err = errors.New("Initialize error: " + err.Error())
+		c.localLogger.Error().Err(err)
+		return err
+	}
+	if retVal != 0 {
+		errorMsg := c.getStringFromCPointer(retVal)
+		c.localLogger.Error().Msg(errorMsg)
+		return errors.New(errorMsg)
+	}
+
+	_, _, err = c.procSetCallback.Call(windows.NewCallback(c.receiveData))
+	if err != windows.Errno(0) {
+		return errors.New("Set callback fn error: " + err.Error())
+	}
+
+	go c.runFreeMemory(appContext)
+
+	return nil
+}
+
+func (c *DllConnector) SetLogLevel(level LogLevel) error {
+	if c.txmlconnector == nil {
+		return errors.New("dll is not loaded")
+	}
+
+	retVal, _, err := c.procSetLogLevel.Call(uintptr(level))
+	if err != windows.Errno(0) {
+		return errors.New("SetLogLevel error: " + err.Error())
+	}
+	if retVal != 0 {
+		return errors.New(c.getStringFromCPointer(retVal))
+	}
+
+	c.options.LogLevel = level
+	c.localLogger.Info().Msgf("connector log level set to %d", level)
+
+	return nil
+}
+
+func (c *DllConnector) runFreeMemory(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case cmsg, ok := <-c.forMemoryFree:
+			if !ok {
+				c.localLogger.Panic().Msg("ForMemoryFree channel was closed")
+			}
+
+			_, _, err := c.procFreeMemory.Call(uintptr(unsafe.Pointer(cmsg)))
+			if err != windows.Errno(0) {
+				c.localLogger.Error().Err(err)
+			}
+		}
+	}
+}
+
+func (c *DllConnector) receiveData(cmsg *C.char) uintptr {
+	msg := C.GoString(cmsg)
+
+	c.callback(msg)
+
+	select {
+	case c.forMemoryFree <- cmsg:
+	default:
+		// channel can be full, so clearing immediately
+		c.getStringFromCPointer(uintptr(unsafe.Pointer(cmsg)))
+		c.localLogger.Warn().Msg("memory for free channel overflow")
+	}
+
+	ok := true
+	return uintptr(unsafe.Pointer(&ok))
+}
+
+func (c *DllConnector) Release() {
+	retVal, _, err := c.procUnInitialize.Call()
+	if err != windows.Errno(0) {
+		c.localLogger.Error().Msgf("dll uninitialized error: %d", err)
+	}
+
+	if retVal != 0 {
+		msg := c.getStringFromCPointer(retVal)
+		c.localLogger.Error().Msg(msg)
+	}
+
+	err = c.txmlconnector.Release()
+	if err != nil {
+		c.localLogger.Error().Err(err)
+	}
+
+	c.txmlconnector = nil
+}
+
+func (c *DllConnector) getStringFromCPointer(pointer uintptr) string {
+	if pointer == 0 {
+		return ""
+	}
+
+	defer func() {
+		_, _, err := c.procFreeMemory.Call(pointer)
+		if err != windows.Errno(0) {
+			c.localLogger.Error().Err(err)
+		}
+	}()
+
+	//goland:noinspection GoVetUnsafePointer
+	cmsg := (*C.char)(unsafe.Pointer(pointer))
+	return C.GoString(cmsg)
+}
+
+func (c *DllConnector) SendCommand(msg string) (string, uint64, error) {
+	cMsg := C.CString(msg)
+	reqData := unsafe.Pointer(cMsg)
+	defer C.free(reqData)
+
+	respPtr, _, err := c.procSendCommand.Call(uintptr(reqData))
+	respData := c.getStringFromCPointer(respPtr)
+
+	c.localLogger.Info().Msg(respData)
+	if err != windows.Errno(0) {
+		windowsError, _ := err.(windows.Errno)
+		c.localLogger.Error().Err(err).Msgf("call error with response ( %d )", uint64(windowsError))
+	}
+
+	if len(respData) > 0 {
+		windowsError, _ := err.(windows.Errno)
+		return respData, uint64(windowsError), nil
+	}
+
+	if err != windows.Errno(0) {
+		windowsError, _ := err.(windows.Errno)
+		return "", uint64(windowsError), errors.New("call error: " + err.Error())
+	}
+
+	return respData, 0, nil
+}
@@ -0,0 +1,376 @@
+package transaq
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/client"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const statusWatchersSize = 16
+
+// CommandObserver is notified about every command sent by clients and its parsed result.
+type CommandObserver func(command string, result *commands.Result)
+
+type TransaqHandler struct {
+	connector     Connector
+	messagesQueue *queue.LanedQueue[Message]
+	dispatcher    *messages.Dispatcher
+	status        *queue.Broadcast[ConnectionStatus]
+	stateMutex    *sync.Mutex
+	// serializes connect and disconnect so the state check and the transition are atomic
+	sessionMutex   *sync.Mutex
+	observersMutex *sync.RWMutex
+	observers      []CommandObserver
+	waitersMutex   *sync.Mutex
+	// RefreshServerStatus calls waiting for the next server_status message
+	statusWaiters []chan ConnectionStatus
+	// nil when the journal is disabled
+	journal *journal.Journal
+	state   *SessionState
+	// the last message sequence
+	sequence    uint64
+	localLogger *zerolog.Logger
+}
+
+func NewTransaqHandler(
+	logger *zerolog.Logger,
+	connector Connector,
+	messagesQueue *queue.LanedQueue[Message],
+	messagesJournal *journal.Journal,
+) *TransaqHandler {
+	localLogger := logger.With().Str("Service", "TransaqHandler").Logger()
+
+	dispatcher := messages.NewDispatcher()
+	dispatcher.OnError(func(data string, err error) {
+		localLogger.Error().Err(err).Msgf("message %s parsing failed", messages.RootOf(data))
+	})
+
+	h := &TransaqHandler{
+		connector:      connector,
+		localLogger:    &localLogger,
+		messagesQueue:  messagesQueue,
+		journal:        messagesJournal,
+		state:          NewSessionState(),
+		dispatcher:     dispatcher,
+		status:         queue.NewBroadcast[ConnectionStatus](statusWatchersSize),
+		stateMutex:     &sync.Mutex{},
+		sessionMutex:   &sync.Mutex{},
+		observersMutex: &sync.RWMutex{},
+		waitersMutex:   &sync.Mutex{},
+	}
+	if messagesJournal != nil {
+		_, h.sequence = messagesJournal.Range()
+	}
+	h.status.Publish(ConnectionStatus{State: ConnectionUninitialized, Time: time.Now()})
+	messages.Handle(dispatcher, h.onServerStatus)
+
+	return h
+}
+
+// Dispatcher gives access to typed callback messages, handlers are called
+// synchronously from the connector callback and must not block.
+func (h *TransaqHandler) Dispatcher() *messages.Dispatcher {
+	return h.dispatcher
+}
+
+// SessionState holds the data a late client needs before the live messages.
+func (h *TransaqHandler) SessionState() *SessionState {
+	return h.state
+}
+
+// Sequence is the last sequence assigned to a received message.
+func (h *TransaqHandler) Sequence() uint64 {
+	return atomic.LoadUint64(&h.sequence)
+}
+
+func (h *TransaqHandler) IsInited() bool {
+	return h.connector.IsInited()
+}
+
+func (h *TransaqHandler) Init(appContext context.Context, _ *client.ClientExists) error {
+	h.connector.SetCallback(h.receiveData)
+
+	err := h.connector.Init(appContext)
+	if err != nil {
+		return err
+	}
+
+	h.transition(ConnectionStatus{State: ConnectionDisconnected}, ConnectionUninitialized)
+
+	return nil
+}
+
+func (h *TransaqHandler) receiveData(msg string) {
+	message := Message{
+		Sequence: atomic.AddUint64(&h.sequence, 1),
+		Received: time.Now(),
+		Type:     messages.RootOf(msg),
+		Data:     msg,
+	}
+
+	// journaled first, a client resuming from the journal must not miss what it got live
+	if h.journal != nil {
+		err := h.journal.Append(message.Record())
+		if err != nil {
+			h.localLogger.Error().Err(err).Msgf("journal append of %s %d failed", message.Type, message.Sequence)
+		}
+	}
+
+	// applied before the push, a snapshot covers whatever a new subscriber missed
+	err := h.state.Apply(message)
+	if err != nil {
+		h.localLogger.Error().Err(err).Msgf("session state update with %s %d failed", message.Type, message.Sequence)
+	}
+
+	h.messagesQueue.Push(laneOf(message.Type), message)
+	h.dispatcher.Dispatch(msg)
+}
+
+// laneOf keeps trading events lossless and ahead of market data.
+func laneOf(root string) queue.Lane {
+	switch root {
+	case messages.RootOrders, messages.RootTrades, messages.RootPositions, messages.RootServerStatus, messages.RootError:
+		return queue.LaneCritical
+	}
+
+	return queue.LaneMarket
+}
+
+func (h *TransaqHandler) onServerStatus(msg *messages.ServerStatus) {
+	// callbacks are the source of truth, they are accepted in any state
+	status := connectionStatusFrom(msg)
+	h.transition(status)
+
+	h.waitersMutex.Lock()
+	waiters := h.statusWaiters
+	h.statusWaiters = nil
+	h.waitersMutex.Unlock()
+
+	for _, waiter := range waiters {
+		waiter <- status
+	}
+}
+
+// RefreshServerStatus sends server_status and waits for the server_status
+// message, the transitions caused by other commands meanwhile are not the
+// answer. Transaq does not mark the answer, a message the server sends on its
+// own right after the command is taken for it.
+func (h *TransaqHandler) RefreshServerStatus(ctx context.Context) (ConnectionStatus, error) {
+	waiter := make(chan ConnectionStatus, 1)
+	h.waitersMutex.Lock()
+	h.statusWaiters = append(h.statusWaiters, waiter)
+	h.waitersMutex.Unlock()
+
+	_, err := h.Send(commands.ServerStatus{})
+	if err != nil {
+		h.removeStatusWaiter(waiter)
+		return ConnectionStatus{}, err
+	}
+
+	select {
+	case status := <-waiter:
+		return status, nil
+	case <-ctx.Done():
+		h.removeStatusWaiter(waiter)
+		return ConnectionStatus{}, ctx.Err()
+	}
+}
+
+func (h *TransaqHandler) removeStatusWaiter(waiter chan ConnectionStatus) {
+	h.waitersMutex.Lock()
+	defer h.waitersMutex.Unlock()
+
+	for i, w := range h.statusWaiters {
+		if w == waiter {
+			h.statusWaiters = append(h.statusWaiters[:i], h.statusWaiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// transition publishes the new status if the current state is one of from,
+// an empty from accepts any state.
+func (h *TransaqHandler) transition(status ConnectionStatus, from ...ConnectionState) bool {
+	h.stateMutex.Lock()
+	defer h.stateMutex.Unlock()
+
+	current := h.ConnectionStatus()
+	if len(from) > 0 && !stateIn(current.State, from) {
+		return false
+	}
+
+	status.Previous = current.State
+	if status.Time.IsZero() {
+		status.Time = time.Now()
+	}
+
+	if status.State != status.Previous {
+		h.localLogger.Info().Msgf("Connection state: %s -> %s %s", status.Previous, status.State, status.Error)
+	}
+	h.status.Publish(status)
+
+	return true
+}
+
+// abandonConnect gives up a connect or a disconnect the connector has not
+// answered with server_status, so that a new connect is accepted.
+func (h *TransaqHandler) abandonConnect(reason string) bool {
+	return h.transition(
+		ConnectionStatus{State: ConnectionDisconnected, Error: reason},
+		ConnectionConnecting, ConnectionDisconnecting,
+	)
+}
+
+func stateIn(state ConnectionState, states []ConnectionState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConnectionStatus returns the current state of the dll and the broker session.
+func (h *TransaqHandler) ConnectionStatus() ConnectionStatus {
+	status, ok := h.status.Last()
+	if !ok {
+		return ConnectionStatus{State: ConnectionUnknown}
+	}
+
+	return status
+}
+
+func (h *TransaqHandler) WatchConnectionStatus(ctx context.Context) <-chan ConnectionStatus {
+	return h.status.Watch(ctx)
+}
+
+func (h *TransaqHandler) Disconnect() {
+	_, err := h.Send(commands.Disconnect{})
+	if err != nil {
+		h.localLogger.Error().Err(err)
+	}
+}
+
+func (h *TransaqHandler) Release() {
+	switch h.ConnectionStatus().State {
+	case ConnectionConnecting, ConnectionConnected, ConnectionRecovering, ConnectionError:
+		h.Disconnect()
+	}
+
+	h.connector.Release()
+	h.transition(ConnectionStatus{State: ConnectionUninitialized})
+}
+
+// SetLogLevel changes the verbosity of the connector logs without reinitialization.
+func (h *TransaqHandler) SetLogLevel(level LogLevel) error {
+	err := level.Validate()
+	if err != nil {
+		return err
+	}
+
+	state := h.ConnectionStatus().State
+	if state == ConnectionUninitialized {
+		return &StateError{State: state, Command: "set_log_level"}
+	}
+
+	return h.connector.SetLogLevel(level)
+}
+
+func (h *TransaqHandler) SendCommand(msg string) (string, uint64, error) {
+	return h.connector.SendCommand(msg)
+}
+
+func (h *TransaqHandler) AddCommandObserver(observer CommandObserver) {
+	h.observersMutex.Lock()
+	defer h.observersMutex.Unlock()
+
+	h.observers = append(h.observers, observer)
+}
+
+// Execute sends a raw command and parses the answer. Unsuccessful answers are
+// returned together with *commands.ResultError.
+func (h *TransaqHandler) Execute(msg string) (*commands.Result, error) {
+	return h.execute(msg, true)
+}
+
+// Send validates and marshals a typed command before it reaches the connector.
+func (h *TransaqHandler) Send(command commands.Command) (*commands.Result, error) {
+	return h.send(command, true)
+}
+
+func (h *TransaqHandler) send(command commands.Command, notify bool) (*commands.Result, error) {
+	msg, err := commands.Marshal(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.execute(msg, notify)
+}
+
+// execute with notify=false is used for commands the service issues on its own,
+// observers only track what clients asked for.
+func (h *TransaqHandler) execute(msg string, notify bool) (*commands.Result, error) {
+	id := commandId(msg)
+	if id == "connect" || id == "disconnect" {
+		h.sessionMutex.Lock()
+		defer h.sessionMutex.Unlock()
+	}
+
+	state := h.ConnectionStatus().State
+	err := checkCommand(state, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, code, err := h.SendCommand(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := commands.ParseResult(resp, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Success {
+		h.onCommandAccepted(id, state)
+	}
+
+	if notify {
+		h.observersMutex.RLock()
+		observers := h.observers
+		h.observersMutex.RUnlock()
+
+		for _, observer := range observers {
+			observer(msg, result)
+		}
+	}
+
+	return result, result.Err()
+}
+
+// onCommandAccepted moves the session into the intermediate state. server_status
+// may overtake the command answer, so the transition only happens from the state
+// the command was checked against.
+func (h *TransaqHandler) onCommandAccepted(id string, state ConnectionState) {
+	switch id {
+	case "connect":
+		h.transition(ConnectionStatus{State: ConnectionConnecting}, state)
+	case "disconnect":
+		h.state.Clear()
+		if state == ConnectionError {
+			// nothing to tear down, the connector is ready for a new connect
+			h.transition(ConnectionStatus{State: ConnectionDisconnected}, state)
+			return
+		}
+		h.transition(ConnectionStatus{State: ConnectionDisconnecting}, state)
+	}
+}
@@ -0,0 +1,34 @@
+package transaq
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/journal"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"time"
+)
+
+// Message is a callback message stamped on receive.
+type Message struct {
+	// monotonic across restarts while the journal is enabled
+	Sequence uint64
+	Received time.Time
+	// root element of Data
+	Type string
+	Data string
+}
+
+func MessageFromRecord(record journal.Record) Message {
+	return Message{
+		Sequence: record.Sequence,
+		Received: record.Time,
+		Type:     messages.RootOf(record.Data),
+		Data:     record.Data,
+	}
+}
+
+func (m Message) Record() journal.Record {
+	return journal.Record{
+		Sequence: m.Sequence,
+		Time:     m.Received,
+		Data:     m.Data,
+	}
+}
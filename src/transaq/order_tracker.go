@@ -0,0 +1,455 @@
+package transaq
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"sort"
+	"sync"
+	"time"
+)
+
+const orderUpdatesSize = 1024
+
+type OrderPhase int
+
+const (
+	// sent to the exchange or waiting for a condition
+	OrderPhasePending OrderPhase = iota
+	OrderPhaseActive
+	OrderPhaseFilled
+	OrderPhaseCancelled
+	OrderPhaseRejected
+	OrderPhaseExpired
+	// a stop order has placed its order
+	OrderPhaseTriggered
+	// a status missing in the Transaq documentation, the order is not final
+	OrderPhaseUnknown
+)
+
+var orderPhases = map[messages.OrderStatus]OrderPhase{
+	messages.OrderForwarding:            OrderPhasePending,
+	messages.OrderInactive:              OrderPhasePending,
+	messages.OrderWait:                  OrderPhasePending,
+	messages.OrderWatching:              OrderPhasePending,
+	messages.OrderLinkWait:              OrderPhasePending,
+	messages.OrderSLForwarding:          OrderPhasePending,
+	messages.OrderSLGuardTime:           OrderPhasePending,
+	messages.OrderTPCorrection:          OrderPhasePending,
+	messages.OrderTPCorrectionGuardTime: OrderPhasePending,
+	messages.OrderTPForwarding:          OrderPhasePending,
+	messages.OrderTPGuardTime:           OrderPhasePending,
+	messages.OrderActive:                OrderPhaseActive,
+	messages.OrderMatched:               OrderPhaseFilled,
+	messages.OrderCancelled:             OrderPhaseCancelled,
+	messages.OrderRemoved:               OrderPhaseCancelled,
+	messages.OrderDenied:                OrderPhaseRejected,
+	messages.OrderDisabled:              OrderPhaseRejected,
+	messages.OrderFailed:                OrderPhaseRejected,
+	messages.OrderRefused:               OrderPhaseRejected,
+	messages.OrderRejected:              OrderPhaseRejected,
+	messages.OrderExpired:               OrderPhaseExpired,
+	messages.OrderSLExecuted:            OrderPhaseTriggered,
+	messages.OrderTPExecuted:            OrderPhaseTriggered,
+}
+
+// PhaseOf tells the phase of a Transaq status, an order without a status yet is pending.
+func PhaseOf(status messages.OrderStatus) OrderPhase {
+	if status == "" {
+		return OrderPhasePending
+	}
+
+	phase, ok := orderPhases[status]
+	if !ok {
+		return OrderPhaseUnknown
+	}
+
+	return phase
+}
+
+// Terminal phases are final, the order gets no more status updates.
+func (p OrderPhase) Terminal() bool {
+	switch p {
+	case OrderPhaseFilled, OrderPhaseCancelled, OrderPhaseRejected, OrderPhaseExpired, OrderPhaseTriggered:
+		return true
+	}
+
+	return false
+}
+
+type OrderTransition struct {
+	Status  messages.OrderStatus
+	Balance int64
+	Result  string
+	Time    time.Time
+}
+
+type OrderFill struct {
+	TradeNo   int64
+	Price     messages.Decimal
+	Quantity  int64
+	Value     messages.Decimal
+	Comission messages.Decimal
+	Time      messages.Time
+}
+
+// TrackedOrder is an order or a stop order of the session with its history.
+type TrackedOrder struct {
+	TransactionId int64
+	Stop          bool
+	// the exchange number, for a stop order the number of the order it placed
+	OrderNo  int64
+	Security commands.SecurityRef
+	SecId    int
+	Client   string
+	Union    string
+	BuySell  messages.BuySell
+	Price    messages.Decimal
+	Quantity int64
+	Balance  int64
+	Status   messages.OrderStatus
+	Result   string
+	// every change of the status or the balance, the first one included
+	Transitions []OrderTransition
+	Fills       []OrderFill
+	// of the last change, grows across every order of the tracker
+	Sequence uint64
+	Created  time.Time
+	Updated  time.Time
+}
+
+func (o *TrackedOrder) Phase() OrderPhase {
+	return PhaseOf(o.Status)
+}
+
+func (o *TrackedOrder) Filled() int64 {
+	var filled int64
+	for _, fill := range o.Fills {
+		filled += fill.Quantity
+	}
+
+	return filled
+}
+
+// Complete tells that the order gets no more updates. The trades of a matched
+// order may come after its status, it is complete with the whole quantity filled.
+func (o *TrackedOrder) Complete() bool {
+	phase := o.Phase()
+	if phase == OrderPhaseFilled && !o.Stop {
+		return o.Filled() >= o.Quantity
+	}
+
+	return phase.Terminal()
+}
+
+func (o *TrackedOrder) copy() TrackedOrder {
+	result := *o
+	result.Transitions = append([]OrderTransition(nil), o.Transitions...)
+	result.Fills = append([]OrderFill(nil), o.Fills...)
+
+	return result
+}
+
+// describe keeps the known values when an update omits them.
+func (o *TrackedOrder) describe(
+	secId int,
+	board string,
+	secCode string,
+	client string,
+	union string,
+	buySell messages.BuySell,
+) {
+	if secId != 0 {
+		o.SecId = secId
+	}
+	if board != "" && secCode != "" {
+		o.Security = commands.SecurityRef{Board: board, SecCode: secCode}
+	}
+	if client != "" {
+		o.Client = client
+	}
+	if union != "" {
+		o.Union = union
+	}
+	if buySell != "" {
+		o.BuySell = buySell
+	}
+}
+
+type OrderQuery struct {
+	Securities []commands.SecurityRef
+	Client     string
+	// only orders not in a terminal phase
+	ActiveOnly bool
+}
+
+func (q *OrderQuery) matches(order *TrackedOrder) bool {
+	if q.ActiveOnly && order.Phase().Terminal() {
+		return false
+	}
+	if q.Client != "" && q.Client != order.Client && q.Client != order.Union {
+		return false
+	}
+	if len(q.Securities) == 0 {
+		return true
+	}
+
+	for _, security := range q.Securities {
+		if security == order.Security {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OrderTracker correlates the orders and trades callbacks by transaction id, so
+// that a client does not have to. The orders are forgotten after disconnect,
+// Transaq sends the orders of the day again on the next connect.
+type OrderTracker struct {
+	mutex  *sync.Mutex
+	orders map[int64]*TrackedOrder
+	// transaction ids of the orders by the exchange number
+	orderNos map[int64]int64
+	tradeNos map[int64]bool
+	// trades received before their order got the exchange number
+	pendingFills map[int64][]OrderFill
+	sequence     uint64
+	updates      *queue.FixedQueue[TrackedOrder]
+
+	localLogger *zerolog.Logger
+}
+
+func NewOrderTracker(logger *zerolog.Logger, handler *TransaqHandler) *OrderTracker {
+	localLogger := logger.With().Str("Service", "OrderTracker").Logger()
+
+	t := &OrderTracker{
+		mutex:       &sync.Mutex{},
+		updates:     queue.NewFixedQueue[TrackedOrder](orderUpdatesSize),
+		localLogger: &localLogger,
+	}
+	t.clear()
+
+	dispatcher := handler.Dispatcher()
+	messages.Handle(dispatcher, t.onOrders)
+	messages.Handle(dispatcher, t.onTrades)
+	handler.AddCommandObserver(t.observeCommand)
+
+	return t
+}
+
+// clear must be called with the mutex held.
+func (t *OrderTracker) clear() {
+	t.orders = map[int64]*TrackedOrder{}
+	t.orderNos = map[int64]int64{}
+	t.tradeNos = map[int64]bool{}
+	t.pendingFills = map[int64][]OrderFill{}
+}
+
+func (t *OrderTracker) observeCommand(command string, result *commands.Result) {
+	if result.Success && commandId(command) == "disconnect" {
+		t.mutex.Lock()
+		t.clear()
+		t.mutex.Unlock()
+
+		t.localLogger.Info().Msg("Orders cleared after disconnect")
+	}
+}
+
+func (t *OrderTracker) onOrders(msg *messages.Orders) {
+	now := time.Now()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for i := range msg.Items {
+		item := &msg.Items[i]
+		order, created := t.order(item.TransactionId, false, now)
+		if item.OrderNo != 0 {
+			order.OrderNo = item.OrderNo
+		}
+		order.describe(item.SecId, item.Board, item.SecCode, item.Client, item.Union, item.BuySell)
+		if !item.Price.IsZero() {
+			order.Price = item.Price
+		}
+		if item.Quantity != 0 {
+			order.Quantity = item.Quantity
+		}
+
+		changed := t.transit(order, item.Status, item.Balance, item.Result, now)
+		changed = t.attachFills(order) || changed
+		if created || changed {
+			t.publish(order, now)
+		}
+	}
+
+	for i := range msg.StopOrders {
+		item := &msg.StopOrders[i]
+		order, created := t.order(item.TransactionId, true, now)
+		if item.ActiveOrderNo != 0 {
+			order.OrderNo = item.ActiveOrderNo
+		}
+		order.describe(item.SecId, item.Board, item.SecCode, item.Client, item.Union, item.BuySell)
+		switch {
+		case item.StopLoss != nil:
+			order.Price = item.StopLoss.ActivationPrice
+			order.Quantity = item.StopLoss.Quantity
+		case item.TakeProfit != nil:
+			order.Price = item.TakeProfit.ActivationPrice
+			order.Quantity = item.TakeProfit.Quantity
+		}
+
+		// a stop order is filled by the order it places
+		if t.transit(order, item.Status, order.Balance, item.Result, now) || created {
+			t.publish(order, now)
+		}
+	}
+}
+
+func (t *OrderTracker) onTrades(msg *messages.Trades) {
+	now := time.Now()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, trade := range msg.Items {
+		if t.tradeNos[trade.TradeNo] {
+			continue
+		}
+		t.tradeNos[trade.TradeNo] = true
+
+		t.pendingFills[trade.OrderNo] = append(t.pendingFills[trade.OrderNo], OrderFill{
+			TradeNo:   trade.TradeNo,
+			Price:     trade.Price,
+			Quantity:  trade.Quantity,
+			Value:     trade.Value,
+			Comission: trade.Comission,
+			Time:      trade.Time,
+		})
+
+		transactionId, ok := t.orderNos[trade.OrderNo]
+		if !ok {
+			continue
+		}
+		order := t.orders[transactionId]
+		if t.attachFills(order) {
+			t.publish(order, now)
+		}
+	}
+}
+
+// order must be called with the mutex held.
+func (t *OrderTracker) order(transactionId int64, stop bool, now time.Time) (*TrackedOrder, bool) {
+	order, ok := t.orders[transactionId]
+	if ok {
+		return order, false
+	}
+
+	order = &TrackedOrder{TransactionId: transactionId, Stop: stop, Created: now}
+	t.orders[transactionId] = order
+
+	return order, true
+}
+
+// transit records a change of the status or the balance, must be called with the mutex held.
+func (t *OrderTracker) transit(
+	order *TrackedOrder,
+	status messages.OrderStatus,
+	balance int64,
+	result string,
+	now time.Time,
+) bool {
+	if status == "" {
+		status = order.Status
+	}
+	if result != "" {
+		order.Result = result
+	}
+
+	if len(order.Transitions) > 0 && status == order.Status && balance == order.Balance {
+		return false
+	}
+	if order.Phase().Terminal() && status != order.Status {
+		t.localLogger.Warn().Msgf("Order %d changes from %s to %s", order.TransactionId, order.Status, status)
+	}
+
+	order.Status = status
+	order.Balance = balance
+	order.Transitions = append(order.Transitions, OrderTransition{
+		Status:  status,
+		Balance: balance,
+		Result:  result,
+		Time:    now,
+	})
+
+	return true
+}
+
+// attachFills moves the trades of the order to it, must be called with the mutex held.
+func (t *OrderTracker) attachFills(order *TrackedOrder) bool {
+	if order.Stop || order.OrderNo == 0 {
+		return false
+	}
+	t.orderNos[order.OrderNo] = order.TransactionId
+
+	fills, ok := t.pendingFills[order.OrderNo]
+	if !ok {
+		return false
+	}
+	delete(t.pendingFills, order.OrderNo)
+
+	order.Fills = append(order.Fills, fills...)
+	sort.Slice(order.Fills, func(i, j int) bool {
+		return order.Fills[i].TradeNo < order.Fills[j].TradeNo
+	})
+
+	return true
+}
+
+// publish must be called with the mutex held, so that the updates keep the order of the sequences.
+func (t *OrderTracker) publish(order *TrackedOrder, now time.Time) {
+	t.sequence++
+	order.Sequence = t.sequence
+	order.Updated = now
+
+	t.updates.Push(order.copy())
+}
+
+func (t *OrderTracker) Order(transactionId int64) (TrackedOrder, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	order, ok := t.orders[transactionId]
+	if !ok {
+		return TrackedOrder{}, false
+	}
+
+	return order.copy(), true
+}
+
+// Orders returns the matching orders by transaction id.
+func (t *OrderTracker) Orders(query OrderQuery) []TrackedOrder {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var result []TrackedOrder
+	for _, order := range t.orders {
+		if query.matches(order) {
+			result = append(result, order.copy())
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TransactionId < result[j].TransactionId
+	})
+
+	return result
+}
+
+// Subscribe returns the changed orders from now on. An order taken afterwards
+// may already hold the first changes, they have sequences not greater than the
+// order one.
+func (t *OrderTracker) Subscribe() *queue.Subscription[TrackedOrder] {
+	return t.updates.Subscribe(queue.SubscribeOptions{Policy: queue.DropOldest, Tail: true})
+}
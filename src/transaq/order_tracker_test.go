@@ -0,0 +1,151 @@
+package transaq
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"testing"
+	"time"
+)
+
+func newTestOrderTracker(t *testing.T) (*TransaqHandler, *OrderTracker) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	handler := NewTransaqHandler(&logger, NewSimulatorConnector(&logger), queue.NewLanedQueue[Message](100, 100), nil)
+
+	return handler, NewOrderTracker(&logger, handler)
+}
+
+func TestPhaseOf(t *testing.T) {
+	tests := []struct {
+		status   messages.OrderStatus
+		phase    OrderPhase
+		terminal bool
+	}{
+		{status: "", phase: OrderPhasePending},
+		{status: messages.OrderForwarding, phase: OrderPhasePending},
+		{status: messages.OrderWatching, phase: OrderPhasePending},
+		{status: messages.OrderTPGuardTime, phase: OrderPhasePending},
+		{status: messages.OrderActive, phase: OrderPhaseActive},
+		{status: messages.OrderMatched, phase: OrderPhaseFilled, terminal: true},
+		{status: messages.OrderRemoved, phase: OrderPhaseCancelled, terminal: true},
+		{status: messages.OrderDenied, phase: OrderPhaseRejected, terminal: true},
+		{status: messages.OrderExpired, phase: OrderPhaseExpired, terminal: true},
+		{status: messages.OrderSLExecuted, phase: OrderPhaseTriggered, terminal: true},
+		{status: "frozen", phase: OrderPhaseUnknown},
+	}
+
+	for _, test := range tests {
+		phase := PhaseOf(test.status)
+		if phase != test.phase || phase.Terminal() != test.terminal {
+			t.Errorf("%q is %d terminal %t, want %d terminal %t",
+				test.status, phase, phase.Terminal(), test.phase, test.terminal)
+		}
+	}
+}
+
+func TestTrackedOrderComplete(t *testing.T) {
+	fills := func(quantities ...int64) []OrderFill {
+		var result []OrderFill
+		for i, quantity := range quantities {
+			result = append(result, OrderFill{TradeNo: int64(i + 1), Quantity: quantity})
+		}
+		return result
+	}
+
+	tests := []struct {
+		name  string
+		order TrackedOrder
+		want  bool
+	}{
+		{name: "active", order: TrackedOrder{Status: messages.OrderActive, Quantity: 10}},
+		{name: "matched without trades", order: TrackedOrder{Status: messages.OrderMatched, Quantity: 10}},
+		{name: "matched partly traded", order: TrackedOrder{Status: messages.OrderMatched, Quantity: 10, Fills: fills(4)}},
+		{name: "matched and traded", order: TrackedOrder{Status: messages.OrderMatched, Quantity: 10, Fills: fills(4, 6)}, want: true},
+		{name: "cancelled partly traded", order: TrackedOrder{Status: messages.OrderCancelled, Quantity: 10, Balance: 6, Fills: fills(4)}, want: true},
+		{name: "rejected", order: TrackedOrder{Status: messages.OrderDenied, Quantity: 10}, want: true},
+		{name: "stop order matched", order: TrackedOrder{Stop: true, Status: messages.OrderMatched, Quantity: 10}, want: true},
+		{name: "unknown status", order: TrackedOrder{Status: "frozen", Quantity: 10}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.order.Complete(); got != test.want {
+				t.Fatalf("complete %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestOrderTrackerCorrelatesTrades(t *testing.T) {
+	handler, tracker := newTestOrderTracker(t)
+	updates := tracker.Subscribe()
+	defer updates.Close()
+
+	// a trade may come before its order gets the exchange number
+	handler.receiveData(`<orders><order transactionid="7"><board>TQBR</board><seccode>SBER</seccode>` +
+		`<status>forwarding</status><buysell>B</buysell><price>270.1</price><quantity>10</quantity><balance>10</balance></order></orders>`)
+	handler.receiveData(`<trades><trade><tradeno>2</tradeno><orderno>100</orderno><quantity>6</quantity><price>270.1</price></trade></trades>`)
+	handler.receiveData(`<orders><order transactionid="7"><orderno>100</orderno><status>matched</status><balance>0</balance></order></orders>`)
+
+	order, ok := tracker.Order(7)
+	if !ok || order.OrderNo != 100 || order.Filled() != 6 || order.Complete() {
+		t.Fatalf("got %+v, want matched with 6 of 10 filled", order)
+	}
+	if order.Security != (commands.SecurityRef{Board: "TQBR", SecCode: "SBER"}) || order.Price.String() != "270.1" {
+		t.Fatalf("the description is lost: %+v", order)
+	}
+
+	// the late trade completes the order, a repeated one is ignored
+	handler.receiveData(`<trades><trade><tradeno>1</tradeno><orderno>100</orderno><quantity>4</quantity></trade></trades>`)
+	handler.receiveData(`<trades><trade><tradeno>1</tradeno><orderno>100</orderno><quantity>4</quantity></trade></trades>`)
+
+	order, _ = tracker.Order(7)
+	if !order.Complete() || order.Filled() != 10 || order.Fills[0].TradeNo != 1 {
+		t.Fatalf("got %+v, want complete with sorted fills", order)
+	}
+	if len(order.Transitions) != 2 {
+		t.Fatalf("transitions %+v, want forwarding and matched", order.Transitions)
+	}
+
+	// created, matched with the early trade, the late trade
+	var sequences []uint64
+	for updates.Lag() > 0 {
+		sequences = append(sequences, nextOrder(t, updates).Sequence)
+	}
+	if len(sequences) != 3 || sequences[2] != order.Sequence {
+		t.Fatalf("updates %v, want 3 up to %d", sequences, order.Sequence)
+	}
+}
+
+func TestOrderTrackerClearsOnDisconnect(t *testing.T) {
+	handler, tracker := newTestOrderTracker(t)
+	handler.receiveData(`<orders><order transactionid="7"><status>active</status></order></orders>`)
+
+	command, err := commands.Marshal(commands.Disconnect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker.observeCommand(command, &commands.Result{Success: true})
+
+	if _, ok := tracker.Order(7); ok {
+		t.Fatal("the order is kept after disconnect")
+	}
+}
+
+func nextOrder(t *testing.T, updates *queue.Subscription[TrackedOrder]) TrackedOrder {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	item, err := updates.Next(ctx)
+	if err != nil {
+		t.Fatalf("no update: %s", err)
+	}
+
+	return item.Value
+}
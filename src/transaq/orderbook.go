@@ -0,0 +1,280 @@
+package transaq
+
+import (
+	"context"
+	"encoding/xml"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"sort"
+	"sync"
+	"time"
+)
+
+const bookUpdatesSize = 4096
+
+type BookLevel struct {
+	Price  messages.Decimal
+	Source string
+	Yield  *int64
+	// 0 in a change removes the level
+	Quantity int64
+}
+
+// BookUpdate is what one quotes message changed in the book of a security.
+type BookUpdate struct {
+	Security commands.SecurityRef
+	SecId    int
+	// previous sequence of the book + 1
+	Sequence uint64
+	Bids     []BookLevel
+	Asks     []BookLevel
+	// the book was emptied, Transaq sends it again after a new subscribe
+	Reset bool
+	Time  time.Time
+}
+
+// BookSnapshot is the book of a security, the best levels first.
+type BookSnapshot struct {
+	Security commands.SecurityRef
+	SecId    int
+	// 0 for a book nothing was received for
+	Sequence uint64
+	Bids     []BookLevel
+	Asks     []BookLevel
+	Time     time.Time
+}
+
+type bookLevelKey struct {
+	price  string
+	source string
+}
+
+type orderBook struct {
+	secId    int
+	sequence uint64
+	bids     map[bookLevelKey]BookLevel
+	asks     map[bookLevelKey]BookLevel
+	updated  time.Time
+}
+
+func newOrderBook() *orderBook {
+	return &orderBook{
+		bids: map[bookLevelKey]BookLevel{},
+		asks: map[bookLevelKey]BookLevel{},
+	}
+}
+
+// applyBookLevel changes the side, -1 removes the level and a missing value leaves it as is.
+func applyBookLevel(side map[bookLevelKey]BookLevel, quote *messages.Quote, quantity *int64) (BookLevel, bool) {
+	if quantity == nil {
+		return BookLevel{}, false
+	}
+
+	key := bookLevelKey{price: quote.Price.String(), source: quote.Source}
+	level := BookLevel{Price: quote.Price, Source: quote.Source, Yield: quote.Yield, Quantity: *quantity}
+
+	if *quantity <= 0 {
+		if _, ok := side[key]; !ok {
+			return BookLevel{}, false
+		}
+		delete(side, key)
+		level.Quantity = 0
+		return level, true
+	}
+
+	side[key] = level
+	return level, true
+}
+
+// OrderBooks rebuilds the order books from the quotes deltas. Every change of a
+// book gets the next sequence of the book, so a client holding a snapshot can
+// tell whether it missed an update. Books are emptied when the quotes are
+// unsubscribed or the session is gone, Transaq sends the whole book again on
+// the next subscribe.
+type OrderBooks struct {
+	mutex   *sync.Mutex
+	handler *TransaqHandler
+	books   map[commands.SecurityRef]*orderBook
+	updates *queue.FixedQueue[BookUpdate]
+
+	localLogger *zerolog.Logger
+}
+
+func NewOrderBooks(logger *zerolog.Logger, handler *TransaqHandler) *OrderBooks {
+	localLogger := logger.With().Str("Service", "OrderBooks").Logger()
+
+	b := &OrderBooks{
+		mutex:       &sync.Mutex{},
+		handler:     handler,
+		books:       map[commands.SecurityRef]*orderBook{},
+		updates:     queue.NewFixedQueue[BookUpdate](bookUpdatesSize),
+		localLogger: &localLogger,
+	}
+	messages.Handle(handler.Dispatcher(), b.onQuotes)
+	handler.AddCommandObserver(b.observeCommand)
+
+	return b
+}
+
+// Run empties the books when the session is gone, a recovering session keeps them.
+func (b *OrderBooks) Run(ctx context.Context) {
+	statuses := b.handler.WatchConnectionStatus(ctx)
+
+	for status := range statuses {
+		switch status.State {
+		case ConnectionConnected, ConnectionRecovering, ConnectionConnecting:
+			continue
+		}
+
+		b.mutex.Lock()
+		for security := range b.books {
+			b.reset(security)
+		}
+		b.mutex.Unlock()
+	}
+}
+
+func (b *OrderBooks) observeCommand(command string, result *commands.Result) {
+	if !result.Success || commandId(command) != "unsubscribe" {
+		return
+	}
+
+	unsubscribe := commands.Subscribe{}
+	err := xml.Unmarshal([]byte(command), &unsubscribe)
+	if err != nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, security := range unsubscribe.Quotes {
+		if _, ok := b.books[security]; ok {
+			b.reset(security)
+		}
+	}
+}
+
+// reset must be called with the mutex held.
+func (b *OrderBooks) reset(security commands.SecurityRef) {
+	book := b.books[security]
+	if len(book.bids) == 0 && len(book.asks) == 0 {
+		return
+	}
+
+	book.bids = map[bookLevelKey]BookLevel{}
+	book.asks = map[bookLevelKey]BookLevel{}
+	book.sequence++
+	book.updated = time.Now()
+
+	b.updates.Push(BookUpdate{
+		Security: security,
+		SecId:    book.secId,
+		Sequence: book.sequence,
+		Reset:    true,
+		Time:     book.updated,
+	})
+	b.localLogger.Debug().Msgf("Order book of %s %s is reset", security.Board, security.SecCode)
+}
+
+func (b *OrderBooks) onQuotes(msg *messages.Quotes) {
+	now := time.Now()
+	updates := map[commands.SecurityRef]*BookUpdate{}
+	var order []commands.SecurityRef
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for i := range msg.Items {
+		quote := &msg.Items[i]
+		security := commands.SecurityRef{Board: quote.Board, SecCode: quote.SecCode}
+
+		book, ok := b.books[security]
+		if !ok {
+			book = newOrderBook()
+			b.books[security] = book
+		}
+		book.secId = quote.SecId
+
+		update, ok := updates[security]
+		if !ok {
+			update = &BookUpdate{Security: security, SecId: quote.SecId, Time: now}
+			updates[security] = update
+			order = append(order, security)
+		}
+
+		if level, ok := applyBookLevel(book.bids, quote, quote.Buy); ok {
+			update.Bids = append(update.Bids, level)
+		}
+		if level, ok := applyBookLevel(book.asks, quote, quote.Sell); ok {
+			update.Asks = append(update.Asks, level)
+		}
+	}
+
+	for _, security := range order {
+		update := updates[security]
+		if len(update.Bids) == 0 && len(update.Asks) == 0 {
+			continue
+		}
+
+		book := b.books[security]
+		book.sequence++
+		book.updated = now
+		update.Sequence = book.sequence
+
+		b.updates.Push(*update)
+	}
+}
+
+// Snapshot returns up to depth best levels of every side, 0 depth returns the whole book.
+func (b *OrderBooks) Snapshot(security commands.SecurityRef, depth int) (BookSnapshot, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	book, ok := b.books[security]
+	if !ok {
+		return BookSnapshot{Security: security}, false
+	}
+
+	return BookSnapshot{
+		Security: security,
+		SecId:    book.secId,
+		Sequence: book.sequence,
+		Bids:     sortedLevels(book.bids, depth, true),
+		Asks:     sortedLevels(book.asks, depth, false),
+		Time:     book.updated,
+	}, true
+}
+
+// Subscribe returns the updates of every book from now on. A snapshot taken
+// afterwards may already hold the first updates, they have sequences not
+// greater than the snapshot one.
+func (b *OrderBooks) Subscribe() *queue.Subscription[BookUpdate] {
+	return b.updates.Subscribe(queue.SubscribeOptions{Policy: queue.DropOldest, Tail: true})
+}
+
+func sortedLevels(side map[bookLevelKey]BookLevel, depth int, descending bool) []BookLevel {
+	levels := make([]BookLevel, 0, len(side))
+	for _, level := range side {
+		levels = append(levels, level)
+	}
+
+	sort.Slice(levels, func(i, j int) bool {
+		compared := levels[i].Price.Cmp(levels[j].Price.Decimal)
+		if compared == 0 {
+			return levels[i].Source < levels[j].Source
+		}
+		if descending {
+			return compared > 0
+		}
+		return compared < 0
+	})
+
+	if depth > 0 && len(levels) > depth {
+		levels = levels[:depth]
+	}
+
+	return levels
+}
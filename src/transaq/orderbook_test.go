@@ -0,0 +1,286 @@
+package transaq
+
+import (
+	"context"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	sberBook = commands.SecurityRef{Board: "TQBR", SecCode: "SBER"}
+	gazpBook = commands.SecurityRef{Board: "TQBR", SecCode: "GAZP"}
+)
+
+// newTestOrderBooks feeds the books through the handler callback, the
+// connector is never initialized.
+func newTestOrderBooks(t *testing.T) (*TransaqHandler, *OrderBooks) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	handler := NewTransaqHandler(&logger, NewSimulatorConnector(&logger), queue.NewLanedQueue[Message](100, 100), nil)
+
+	return handler, NewOrderBooks(&logger, handler)
+}
+
+// quote renders a quote of the security, an empty side is left out.
+func quote(security commands.SecurityRef, price string, source string, buy string, sell string) string {
+	var sides string
+	if buy != "" {
+		sides += "<buy>" + buy + "</buy>"
+	}
+	if sell != "" {
+		sides += "<sell>" + sell + "</sell>"
+	}
+
+	return fmt.Sprintf(`<quote secid="1"><board>%s</board><seccode>%s</seccode><price>%s</price><source>%s</source>%s</quote>`,
+		security.Board, security.SecCode, price, source, sides)
+}
+
+func quotes(items ...string) string {
+	return "<quotes>" + strings.Join(items, "") + "</quotes>"
+}
+
+// renderLevels lists the levels as price/source:quantity.
+func renderLevels(levels []BookLevel) string {
+	rendered := make([]string, 0, len(levels))
+	for _, level := range levels {
+		text := level.Price.String()
+		if level.Source != "" {
+			text += "/" + level.Source
+		}
+		rendered = append(rendered, fmt.Sprintf("%s:%d", text, level.Quantity))
+	}
+
+	return strings.Join(rendered, " ")
+}
+
+func expectBook(t *testing.T, books *OrderBooks, security commands.SecurityRef, sequence uint64, bids string, asks string) {
+	t.Helper()
+
+	book, _ := books.Snapshot(security, 0)
+	if book.Sequence != sequence || renderLevels(book.Bids) != bids || renderLevels(book.Asks) != asks {
+		t.Fatalf("book %d bids [%s] asks [%s], want %d bids [%s] asks [%s]",
+			book.Sequence, renderLevels(book.Bids), renderLevels(book.Asks), sequence, bids, asks)
+	}
+}
+
+func nextUpdate(t *testing.T, updates *queue.Subscription[BookUpdate]) BookUpdate {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	item, err := updates.Next(ctx)
+	if err != nil {
+		t.Fatalf("no update: %s", err)
+	}
+
+	return item.Value
+}
+
+func TestOrderBooksApplyQuotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		sequence uint64
+		bids     string
+		asks     string
+	}{
+		{
+			name: "levels sorted best first",
+			messages: []string{quotes(
+				quote(sberBook, "270.1", "", "10", ""),
+				quote(sberBook, "270.3", "", "5", ""),
+				quote(sberBook, "270.5", "", "", "7"),
+				quote(sberBook, "270.4", "", "", "3"),
+			)},
+			sequence: 1,
+			bids:     "270.3:5 270.1:10",
+			asks:     "270.4:3 270.5:7",
+		},
+		{
+			name: "quantity replaced",
+			messages: []string{
+				quotes(quote(sberBook, "270.1", "", "10", "")),
+				quotes(quote(sberBook, "270.1", "", "4", "")),
+			},
+			sequence: 2,
+			bids:     "270.1:4",
+		},
+		{
+			name: "removed with -1",
+			messages: []string{
+				quotes(quote(sberBook, "270.1", "", "10", ""), quote(sberBook, "270.5", "", "", "7")),
+				quotes(quote(sberBook, "270.1", "", "-1", "")),
+			},
+			sequence: 2,
+			asks:     "270.5:7",
+		},
+		{
+			name: "removed with 0",
+			messages: []string{
+				quotes(quote(sberBook, "270.1", "", "10", ""), quote(sberBook, "270.5", "", "", "7")),
+				quotes(quote(sberBook, "270.5", "", "", "0")),
+			},
+			sequence: 2,
+			bids:     "270.1:10",
+		},
+		{
+			name: "missing side left as is",
+			messages: []string{
+				quotes(quote(sberBook, "270.1", "", "10", "")),
+				quotes(quote(sberBook, "270.1", "", "", "2")),
+			},
+			sequence: 2,
+			bids:     "270.1:10",
+			asks:     "270.1:2",
+		},
+		{
+			name: "removing an unknown level changes nothing",
+			messages: []string{
+				quotes(quote(sberBook, "270.1", "", "10", "")),
+				quotes(quote(sberBook, "270.2", "", "-1", "")),
+			},
+			sequence: 1,
+			bids:     "270.1:10",
+		},
+		{
+			name: "price and source are the key",
+			messages: []string{
+				quotes(quote(sberBook, "270.1", "MICEX", "10", ""), quote(sberBook, "270.1", "RTS", "3", "")),
+				quotes(quote(sberBook, "270.1", "MICEX", "-1", "")),
+			},
+			sequence: 2,
+			bids:     "270.1/RTS:3",
+		},
+		{
+			name: "same price in another notation",
+			messages: []string{
+				quotes(quote(sberBook, "270.10", "", "10", "")),
+				quotes(quote(sberBook, "270.1", "", "-1", "")),
+			},
+			sequence: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, books := newTestOrderBooks(t)
+			for _, msg := range test.messages {
+				handler.receiveData(msg)
+			}
+
+			expectBook(t, books, sberBook, test.sequence, test.bids, test.asks)
+		})
+	}
+}
+
+func TestOrderBooksSequencePerBook(t *testing.T) {
+	handler, books := newTestOrderBooks(t)
+	updates := books.Subscribe()
+	defer updates.Close()
+
+	// one message changes both books, each gets its own sequence
+	handler.receiveData(quotes(quote(sberBook, "270.1", "", "10", ""), quote(gazpBook, "160.2", "", "5", "")))
+	handler.receiveData(quotes(quote(sberBook, "270.2", "", "3", "")))
+
+	var got []string
+	for i := 0; i < 3; i++ {
+		update := nextUpdate(t, updates)
+		got = append(got, fmt.Sprintf("%s:%d", update.Security.SecCode, update.Sequence))
+	}
+
+	if want := "[SBER:1 GAZP:1 SBER:2]"; fmt.Sprint(got) != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	expectBook(t, books, gazpBook, 1, "160.2:5", "")
+}
+
+func TestOrderBooksSnapshotThenDeltas(t *testing.T) {
+	handler, books := newTestOrderBooks(t)
+	handler.receiveData(quotes(quote(sberBook, "270.1", "", "10", "")))
+	handler.receiveData(quotes(quote(sberBook, "270.5", "", "", "7")))
+
+	// what was pushed before the subscribe is in the snapshot only
+	updates := books.Subscribe()
+	defer updates.Close()
+	snapshot, ok := books.Snapshot(sberBook, 0)
+	if !ok || snapshot.Sequence != 2 {
+		t.Fatalf("got %+v", snapshot)
+	}
+	if lag := updates.Lag(); lag != 0 {
+		t.Fatalf("%d updates before the subscribe are received", lag)
+	}
+
+	handler.receiveData(quotes(quote(sberBook, "270.1", "", "-1", ""), quote(sberBook, "270.2", "", "4", "")))
+	handler.receiveData(quotes(quote(sberBook, "270.5", "", "", "9")))
+
+	// the deltas rebuild the book from the snapshot
+	bids := map[string]BookLevel{}
+	for _, level := range snapshot.Bids {
+		bids[level.Price.String()] = level
+	}
+	asks := map[string]BookLevel{}
+	for _, level := range snapshot.Asks {
+		asks[level.Price.String()] = level
+	}
+	sequence := snapshot.Sequence
+	for i := 0; i < 2; i++ {
+		update := nextUpdate(t, updates)
+		if update.Sequence != sequence+1 {
+			t.Fatalf("delta %d after %d", update.Sequence, sequence)
+		}
+		sequence = update.Sequence
+
+		for side, levels := range map[*map[string]BookLevel][]BookLevel{&bids: update.Bids, &asks: update.Asks} {
+			for _, level := range levels {
+				if level.Quantity == 0 {
+					delete(*side, level.Price.String())
+					continue
+				}
+				(*side)[level.Price.String()] = level
+			}
+		}
+	}
+
+	book, _ := books.Snapshot(sberBook, 0)
+	if book.Sequence != sequence || len(bids) != len(book.Bids) || len(asks) != len(book.Asks) {
+		t.Fatalf("rebuilt %v %v at %d, the book is %+v", bids, asks, sequence, book)
+	}
+	for _, level := range book.Bids {
+		if bids[level.Price.String()].Quantity != level.Quantity {
+			t.Fatalf("bid %s rebuilt as %+v", level.Price, bids[level.Price.String()])
+		}
+	}
+	for _, level := range book.Asks {
+		if asks[level.Price.String()].Quantity != level.Quantity {
+			t.Fatalf("ask %s rebuilt as %+v", level.Price, asks[level.Price.String()])
+		}
+	}
+}
+
+func TestOrderBooksResetOnUnsubscribe(t *testing.T) {
+	handler, books := newTestOrderBooks(t)
+	handler.receiveData(quotes(quote(sberBook, "270.1", "", "10", ""), quote(gazpBook, "160.2", "", "5", "")))
+
+	updates := books.Subscribe()
+	defer updates.Close()
+
+	command, err := commands.Marshal(commands.Unsubscribe(commands.Subscribe{Quotes: []commands.SecurityRef{sberBook}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	books.observeCommand(command, &commands.Result{Success: true})
+
+	update := nextUpdate(t, updates)
+	if !update.Reset || update.Security != sberBook || update.Sequence != 2 {
+		t.Fatalf("got %+v, want the reset of sber", update)
+	}
+	expectBook(t, books, sberBook, 2, "", "")
+	expectBook(t, books, gazpBook, 1, "160.2:5", "")
+}
@@ -0,0 +1,157 @@
+package transaq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/rs/zerolog"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// ReplaySpeedMax feeds the capture as fast as the consumer accepts it.
+const ReplaySpeedMax = 0
+
+// ReplayConnector plays callback messages of a capture back with the original
+// pacing divided by speed. Commands are not executed, they are answered with a
+// successful result so that clients keep working against the replayed stream.
+type ReplayConnector struct {
+	mutex       *sync.Mutex
+	path        string
+	speed       float64
+	callback    MessageCallback
+	cancel      context.CancelFunc
+	inited      bool
+	localLogger *zerolog.Logger
+}
+
+func NewReplayConnector(logger *zerolog.Logger, path string, speed float64) (*ReplayConnector, error) {
+	if path == "" {
+		return nil, errors.New("replay capture path is empty")
+	}
+	if speed < 0 {
+		return nil, errors.New("replay speed must not be negative")
+	}
+
+	localLogger := logger.With().Str("Service", "ReplayConnector").Logger()
+
+	return &ReplayConnector{
+		mutex:       &sync.Mutex{},
+		path:        path,
+		speed:       speed,
+		callback:    func(string) {},
+		localLogger: &localLogger,
+	}, nil
+}
+
+func (c *ReplayConnector) SetCallback(callback MessageCallback) {
+	c.callback = callback
+}
+
+func (c *ReplayConnector) IsInited() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.inited
+}
+
+func (c *ReplayConnector) Init(appContext context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.inited {
+		return errors.New("replay already initialized")
+	}
+
+	file, err := os.Open(c.path)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(appContext)
+	c.cancel = cancel
+	c.inited = true
+
+	go c.run(ctx, file)
+
+	return nil
+}
+
+func (c *ReplayConnector) Release() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.inited {
+		return
+	}
+
+	c.cancel()
+	c.inited = false
+}
+
+func (c *ReplayConnector) SendCommand(msg string) (string, uint64, error) {
+	c.localLogger.Info().Msgf("command ignored in replay mode: %s", msg)
+
+	return "<result success=\"true\"/>", 0, nil
+}
+
+func (c *ReplayConnector) SetLogLevel(level LogLevel) error {
+	c.localLogger.Info().Msgf("log level %d ignored in replay mode", level)
+
+	return nil
+}
+
+func (c *ReplayConnector) run(ctx context.Context, file *os.File) {
+	defer func() {
+		_ = file.Close()
+	}()
+
+	decoder := json.NewDecoder(file)
+	var firstRecordTime time.Time
+	startTime := time.Now()
+	count := 0
+
+	for {
+		record := CaptureRecord{}
+		err := decoder.Decode(&record)
+		if err == io.EOF {
+			c.localLogger.Info().Msgf("replay finished, %d messages", count)
+			return
+		}
+		if err != nil {
+			c.localLogger.Error().Err(err).Msg("capture read failed")
+			return
+		}
+
+		if record.Kind != CaptureMessage {
+			continue
+		}
+
+		if firstRecordTime.IsZero() {
+			firstRecordTime = record.Time
+		}
+
+		if c.speed != ReplaySpeedMax {
+			offset := time.Duration(float64(record.Time.Sub(firstRecordTime)) / c.speed)
+			timer := time.NewTimer(time.Until(startTime.Add(offset)))
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		} else {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+		}
+
+		c.callback(record.Data)
+		count++
+	}
+}
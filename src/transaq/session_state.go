@@ -0,0 +1,212 @@
+package transaq
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"strings"
+	"sync"
+	"time"
+)
+
+// snapshotRoots are sent once by Transaq and rendered for a late subscriber in this order.
+var snapshotRoots = []string{
+	messages.RootMarkets,
+	messages.RootBoards,
+	messages.RootCandleKinds,
+	messages.RootSecurities,
+	messages.RootClient,
+	messages.RootPositions,
+	messages.RootOrders,
+	messages.RootTrades,
+}
+
+// positionKeyFields tell apart positions of the same kind.
+var positionKeyFields = []string{"client", "union", "asset", "secid", "seccode", "register"}
+
+// entitySet keeps raw entities by key in the order they first came.
+type entitySet struct {
+	keys     []string
+	entities map[string]string
+}
+
+func newEntitySet() *entitySet {
+	return &entitySet{entities: map[string]string{}}
+}
+
+func (s *entitySet) put(key string, raw string) {
+	if _, ok := s.entities[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.entities[key] = raw
+}
+
+func (s *entitySet) remove(key string) {
+	if _, ok := s.entities[key]; !ok {
+		return
+	}
+
+	delete(s.entities, key)
+	for i, k := range s.keys {
+		if k == key {
+			s.keys = append(s.keys[:i], s.keys[i+1:]...)
+			return
+		}
+	}
+}
+
+// SessionState keeps the latest reference and account data of the session, an
+// updated entity replaces the stored one. It is applied before the message is
+// queued, so a snapshot holds every message up to its sequence.
+type SessionState struct {
+	mutex *sync.Mutex
+	sets  map[string]*entitySet
+	// the last sequence applied
+	sequence uint64
+}
+
+func NewSessionState() *SessionState {
+	s := &SessionState{
+		mutex: &sync.Mutex{},
+	}
+	s.Clear()
+
+	return s
+}
+
+// Tracks tells whether messages of the root are part of the snapshot.
+func (s *SessionState) Tracks(root string) bool {
+	for _, tracked := range snapshotRoots {
+		if tracked == root {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clear forgets the session, the next one may be of another account.
+func (s *SessionState) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sets = make(map[string]*entitySet, len(snapshotRoots))
+	for _, root := range snapshotRoots {
+		s.sets[root] = newEntitySet()
+	}
+}
+
+func (s *SessionState) Apply(message Message) error {
+	if !s.Tracks(message.Type) {
+		return nil
+	}
+
+	if message.Type == messages.RootClient {
+		return s.applyClient(message)
+	}
+
+	entities, err := messages.SplitEntities(message.Data)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	set := s.sets[message.Type]
+	s.sequence = message.Sequence
+
+	for i := range entities {
+		key := entityKey(message.Type, &entities[i])
+		if key != "" {
+			set.put(key, entities[i].Raw)
+		}
+	}
+
+	return nil
+}
+
+// applyClient keeps a client message as a whole, one per client.
+func (s *SessionState) applyClient(message Message) error {
+	parsed, err := messages.Parse(message.Data)
+	if err != nil {
+		return err
+	}
+	client, ok := parsed.(*messages.Client)
+	if !ok {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	set := s.sets[messages.RootClient]
+	s.sequence = message.Sequence
+
+	if client.Remove {
+		set.remove(client.Id)
+	} else {
+		set.put(client.Id, message.Data)
+	}
+
+	return nil
+}
+
+// entityKey is empty for an entity that can not be told apart.
+func entityKey(root string, entity *messages.Entity) string {
+	switch root {
+	case messages.RootMarkets, messages.RootBoards, messages.RootCandleKinds:
+		return entity.Value("id")
+	case messages.RootSecurities:
+		return entity.Value("secid")
+	case messages.RootOrders:
+		id := entity.Value("transactionid")
+		if id == "" {
+			return ""
+		}
+		return entity.Name + "|" + id
+	case messages.RootTrades:
+		return entity.Value("tradeno")
+	case messages.RootPositions:
+		key := entity.Name
+		for _, field := range positionKeyFields {
+			key += "|" + entity.Value(field)
+		}
+		return key
+	}
+
+	return ""
+}
+
+// Snapshot renders the state as callback messages and returns the last
+// sequence they hold.
+func (s *SessionState) Snapshot() ([]Message, uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	var snapshot []Message
+
+	for _, root := range snapshotRoots {
+		set := s.sets[root]
+		if len(set.keys) == 0 {
+			continue
+		}
+
+		if root == messages.RootClient {
+			for _, key := range set.keys {
+				snapshot = append(snapshot, Message{Sequence: s.sequence, Received: now, Type: root, Data: set.entities[key]})
+			}
+			continue
+		}
+
+		builder := &strings.Builder{}
+		builder.WriteString("<" + root + ">")
+		for _, key := range set.keys {
+			builder.WriteString(set.entities[key])
+		}
+		builder.WriteString("</" + root + ">")
+
+		snapshot = append(snapshot, Message{Sequence: s.sequence, Received: now, Type: root, Data: builder.String()})
+	}
+
+	return snapshot, s.sequence
+}
@@ -0,0 +1,162 @@
+package transaq
+
+import (
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"testing"
+)
+
+func applyAll(t *testing.T, state *SessionState, data ...string) {
+	t.Helper()
+
+	for i, d := range data {
+		err := state.Apply(Message{Sequence: uint64(i + 1), Type: messages.RootOf(d), Data: d})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func renderSnapshot(snapshot []Message) []string {
+	rendered := make([]string, 0, len(snapshot))
+	for _, message := range snapshot {
+		rendered = append(rendered, message.Data)
+	}
+
+	return rendered
+}
+
+func TestSessionStateSnapshot(t *testing.T) {
+	tests := []struct {
+		name string
+		data []string
+		want []string
+	}{
+		{
+			name: "updated in place",
+			data: []string{
+				`<securities><security secid="1"><seccode>SBER</seccode></security><security secid="2"><seccode>GAZP</seccode></security></securities>`,
+				`<securities><security secid="1"><seccode>SBER</seccode><active>false</active></security></securities>`,
+			},
+			want: []string{
+				`<securities><security secid="1"><seccode>SBER</seccode><active>false</active></security><security secid="2"><seccode>GAZP</seccode></security></securities>`,
+			},
+		},
+		{
+			name: "reference data first",
+			data: []string{
+				`<orders><order transactionid="5"><status>active</status></order></orders>`,
+				`<positions><money_position><client>C1</client><asset>RUR</asset><saldo>1</saldo></money_position></positions>`,
+				`<markets><market id="1">MICEX</market></markets>`,
+			},
+			want: []string{
+				`<markets><market id="1">MICEX</market></markets>`,
+				`<positions><money_position><client>C1</client><asset>RUR</asset><saldo>1</saldo></money_position></positions>`,
+				`<orders><order transactionid="5"><status>active</status></order></orders>`,
+			},
+		},
+		{
+			name: "orders and stop orders apart",
+			data: []string{
+				`<orders><order transactionid="5"><status>active</status></order><stoporder transactionid="5"><status>watching</status></stoporder></orders>`,
+				`<orders><order transactionid="5"><status>matched</status></order></orders>`,
+			},
+			want: []string{
+				`<orders><order transactionid="5"><status>matched</status></order><stoporder transactionid="5"><status>watching</status></stoporder></orders>`,
+			},
+		},
+		{
+			name: "positions by account and asset",
+			data: []string{
+				`<positions><money_position><client>C1</client><asset>RUR</asset><saldo>1</saldo></money_position>` +
+					`<money_position><client>C2</client><asset>RUR</asset><saldo>2</saldo></money_position>` +
+					`<sec_position><client>C1</client><secid>1</secid><saldo>3</saldo></sec_position></positions>`,
+				`<positions><money_position><client>C2</client><asset>RUR</asset><saldo>4</saldo></money_position></positions>`,
+			},
+			want: []string{
+				`<positions><money_position><client>C1</client><asset>RUR</asset><saldo>1</saldo></money_position>` +
+					`<money_position><client>C2</client><asset>RUR</asset><saldo>4</saldo></money_position>` +
+					`<sec_position><client>C1</client><secid>1</secid><saldo>3</saldo></sec_position></positions>`,
+			},
+		},
+		{
+			name: "clients one per message",
+			data: []string{
+				`<client id="C1"><type>spot</type></client>`,
+				`<client id="C2"><type>mct</type></client>`,
+				`<client id="C1" remove="true"/>`,
+			},
+			want: []string{`<client id="C2"><type>mct</type></client>`},
+		},
+		{
+			name: "without a key",
+			data: []string{`<orders><order><status>active</status></order></orders>`},
+		},
+		{
+			name: "not tracked",
+			data: []string{
+				`<quotations><quotation secid="1"><last>1</last></quotation></quotations>`,
+				`<server_status connected="true"/>`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := NewSessionState()
+			applyAll(t, state, test.data...)
+
+			snapshot, _ := state.Snapshot()
+			if got := renderSnapshot(snapshot); fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Fatalf("got\n%v\nwant\n%v", got, test.want)
+			}
+			for _, message := range snapshot {
+				if message.Type != messages.RootOf(message.Data) {
+					t.Fatalf("%s holds %s", message.Type, message.Data)
+				}
+			}
+		})
+	}
+}
+
+func TestSessionStateSequence(t *testing.T) {
+	state := NewSessionState()
+	applyAll(t, state,
+		`<markets><market id="1">MICEX</market></markets>`,
+		`<client id="C1"><type>spot</type></client>`,
+		// not tracked, the snapshot does not hold it
+		`<quotations><quotation secid="1"><last>1</last></quotation></quotations>`,
+	)
+
+	snapshot, sequence := state.Snapshot()
+	if sequence != 2 {
+		t.Fatalf("the snapshot holds up to %d, want 2", sequence)
+	}
+	for _, message := range snapshot {
+		if message.Sequence != sequence {
+			t.Fatalf("%s has sequence %d, want %d", message.Type, message.Sequence, sequence)
+		}
+	}
+
+	if !state.Tracks(messages.RootOrders) || state.Tracks(messages.RootQuotations) {
+		t.Fatal("tracks the wrong roots")
+	}
+
+	// the next session may be of another account
+	state.Clear()
+	if snapshot, _ := state.Snapshot(); len(snapshot) != 0 {
+		t.Fatalf("got %v after clear", renderSnapshot(snapshot))
+	}
+}
+
+func TestSessionStateMalformed(t *testing.T) {
+	state := NewSessionState()
+
+	err := state.Apply(Message{Sequence: 1, Type: messages.RootOrders, Data: `<orders><order transactionid="1"></orders>`})
+	if err == nil {
+		t.Fatal("a malformed message is applied")
+	}
+	if snapshot, _ := state.Snapshot(); len(snapshot) != 0 {
+		t.Fatalf("got %v", renderSnapshot(snapshot))
+	}
+}
@@ -0,0 +1,925 @@
+package transaq
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/rs/zerolog"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	simulatorTickInterval = time.Millisecond * 500
+	simulatorEventsSize   = 1000
+	simulatorBookDepth    = 5
+	simulatorClientId     = "SIM0001"
+	simulatorUnion        = "SIMU01"
+	simulatorDateLayout   = "02.01.2006 15:04:05.000"
+	simulatorTimeLayout   = "15:04:05"
+)
+
+var simulatorLocation = time.FixedZone("MSK", 3*60*60)
+
+type simulatorSecurity struct {
+	secId      int
+	secCode    string
+	board      string
+	market     int
+	shortName  string
+	secType    string
+	instrClass string
+	decimals   int
+	minStep    int64
+	lotSize    int64
+	// prices are kept in units of 10^-decimals to avoid float rounding
+	openPrice int64
+	lastPrice int64
+	numTrades int64
+	volToday  int64
+	book      map[int64]simulatorLevel
+}
+
+type simulatorLevel struct {
+	buy  int64
+	sell int64
+}
+
+type simulatorSubscription struct {
+	allTrades  bool
+	quotations bool
+	quotes     bool
+}
+
+type simulatorOrder struct {
+	transactionId int64
+	orderNo       int64
+	security      *simulatorSecurity
+	buySell       string
+	price         int64
+	quantity      int64
+	balance       int64
+	brokerRef     string
+	status        string
+	// None for plain orders
+	condition string
+	// stoploss or takeprofit of a stop order, empty for the others
+	leg        string
+	activation int64
+}
+
+type simulatorSecurityRef struct {
+	SecId   int    `xml:"secid"`
+	Board   string `xml:"board"`
+	SecCode string `xml:"seccode"`
+}
+
+type simulatorCommand struct {
+	XMLName    xml.Name               `xml:"command"`
+	Id         string                 `xml:"id,attr"`
+	Login      string                 `xml:"login"`
+	Password   string                 `xml:"password"`
+	Host       string                 `xml:"host"`
+	Port       string                 `xml:"port"`
+	Security   simulatorSecurityRef   `xml:"security"`
+	SecId      int                    `xml:"secid"`
+	AllTrades  []simulatorSecurityRef `xml:"alltrades>security"`
+	Quotations []simulatorSecurityRef `xml:"quotations>security"`
+	Quotes     []simulatorSecurityRef `xml:"quotes>security"`
+	Price      string                 `xml:"price"`
+	Quantity   int64                  `xml:"quantity"`
+	BuySell    string                 `xml:"buysell"`
+	ByMarket   *struct{}              `xml:"bymarket"`
+	BrokerRef  string                 `xml:"brokerref"`
+	// cancelorder and moveorder
+	TransactionId int64 `xml:"transactionid"`
+	MoveFlag      int   `xml:"moveflag"`
+	// newcondorder and newstoporder
+	CondType   string            `xml:"cond_type"`
+	StopLoss   *simulatorStopLeg `xml:"stoploss"`
+	TakeProfit *simulatorStopLeg `xml:"takeprofit"`
+}
+
+type simulatorStopLeg struct {
+	ActivationPrice string `xml:"activationprice"`
+	Quantity        int64  `xml:"quantity"`
+}
+
+// SimulatorConnector is an in-process stand-in for the TXmlConnector DLL.
+// It answers the basic session, reference data, market data and order commands
+// with callbacks shaped like the real ones, so the service can run without Wine.
+type SimulatorConnector struct {
+	mutex         *sync.Mutex
+	callback      MessageCallback
+	events        chan string
+	cancel        context.CancelFunc
+	inited        bool
+	connected     bool
+	random        *rand.Rand
+	securities    []*simulatorSecurity
+	subscriptions map[int]*simulatorSubscription
+	orders        []*simulatorOrder
+	// conditional and stop orders, their conditions never come true
+	watching      []*simulatorOrder
+	transactionId int64
+	orderNo       int64
+	tradeNo       int64
+	localLogger   *zerolog.Logger
+}
+
+func NewSimulatorConnector(logger *zerolog.Logger) *SimulatorConnector {
+	localLogger := logger.With().Str("Service", "SimulatorConnector").Logger()
+
+	return &SimulatorConnector{
+		mutex:       &sync.Mutex{},
+		callback:    func(string) {},
+		localLogger: &localLogger,
+	}
+}
+
+func (c *SimulatorConnector) SetCallback(callback MessageCallback) {
+	c.callback = callback
+}
+
+func (c *SimulatorConnector) IsInited() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.inited
+}
+
+func (c *SimulatorConnector) Init(appContext context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.inited {
+		return errors.New("simulator already initialized")
+	}
+
+	ctx, cancel := context.WithCancel(appContext)
+	c.cancel = cancel
+	c.events = make(chan string, simulatorEventsSize)
+	c.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	c.securities = newSimulatorSecurities()
+	c.subscriptions = map[int]*simulatorSubscription{}
+	c.orders = nil
+	c.watching = nil
+	c.connected = false
+	c.inited = true
+
+	go c.runCallbacks(ctx, c.events)
+	go c.runMarket(ctx)
+
+	c.localLogger.Info().Msg("simulator initialized")
+
+	return nil
+}
+
+func (c *SimulatorConnector) SetLogLevel(level LogLevel) error {
+	c.localLogger.Info().Msgf("log level %d ignored by simulator", level)
+
+	return nil
+}
+
+func (c *SimulatorConnector) Release() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.inited {
+		return
+	}
+
+	c.cancel()
+	c.inited = false
+	c.connected = false
+}
+
+func (c *SimulatorConnector) SendCommand(msg string) (string, uint64, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.inited {
+		return "", 0, errors.New("simulator is not initialized")
+	}
+
+	command := simulatorCommand{}
+	err := xml.Unmarshal([]byte(msg), &command)
+	if err != nil {
+		return "<error>" + escapeSimulatorText("Wrong command format: "+err.Error()) + "</error>", 0, nil
+	}
+
+	// the real connector accepts disconnect after a connection error
+	if command.Id != "connect" && command.Id != "disconnect" && !c.connected {
+		return simulatorResultError("Not connected to server"), 0, nil
+	}
+
+	switch command.Id {
+	case "connect":
+		return c.connect(&command), 0, nil
+	case "disconnect":
+		return c.disconnect(), 0, nil
+	case "server_status":
+		c.emit(c.serverStatus())
+		return simulatorResultSuccess(), 0, nil
+	case "get_securities":
+		c.emit(c.securitiesMessage())
+		return simulatorResultSuccess(), 0, nil
+	case "subscribe":
+		return c.subscribe(&command, true), 0, nil
+	case "unsubscribe":
+		return c.subscribe(&command, false), 0, nil
+	case "neworder":
+		return c.newOrder(&command), 0, nil
+	case "newcondorder":
+		return c.newCondOrder(&command), 0, nil
+	case "newstoporder":
+		return c.newStopOrder(&command), 0, nil
+	case "cancelorder":
+		return c.cancelOrder(&command), 0, nil
+	case "cancelstoporder":
+		return c.cancelStopOrder(&command), 0, nil
+	case "moveorder":
+		return c.moveOrder(&command), 0, nil
+	}
+
+	return simulatorResultError(fmt.Sprintf("Command %s is not supported by simulator", command.Id)), 0, nil
+}
+
+func (c *SimulatorConnector) connect(command *simulatorCommand) string {
+	if c.connected {
+		return simulatorResultError("Already connected")
+	}
+
+	if command.Login == "" || command.Password == "" || command.Host == "" || command.Port == "" {
+		return simulatorResultError("login, password, host and port are required")
+	}
+
+	c.connected = true
+
+	c.emit(c.marketsMessage())
+	c.emit(c.boardsMessage())
+	c.emit(simulatorCandleKinds)
+	c.emit(c.securitiesMessage())
+	c.emit(c.clientMessage())
+	c.emit(c.positionsMessage())
+	c.emit(c.serverStatus())
+
+	return simulatorResultSuccess()
+}
+
+func (c *SimulatorConnector) disconnect() string {
+	c.connected = false
+	c.subscriptions = map[int]*simulatorSubscription{}
+	c.emit(c.serverStatus())
+
+	return simulatorResultSuccess()
+}
+
+func (c *SimulatorConnector) subscribe(command *simulatorCommand, enable bool) string {
+	type kindRefs struct {
+		refs  []simulatorSecurityRef
+		apply func(s *simulatorSubscription)
+	}
+
+	kinds := []kindRefs{
+		{refs: command.AllTrades, apply: func(s *simulatorSubscription) { s.allTrades = enable }},
+		{refs: command.Quotations, apply: func(s *simulatorSubscription) { s.quotations = enable }},
+		{refs: command.Quotes, apply: func(s *simulatorSubscription) { s.quotes = enable }},
+	}
+
+	for _, kind := range kinds {
+		for _, ref := range kind.refs {
+			if c.findSecurity(ref) == nil {
+				return simulatorResultError(fmt.Sprintf("Unknown security %s %s", ref.Board, ref.SecCode))
+			}
+		}
+	}
+
+	for _, kind := range kinds {
+		for _, ref := range kind.refs {
+			security := c.findSecurity(ref)
+			subscription, ok := c.subscriptions[security.secId]
+			if !ok {
+				subscription = &simulatorSubscription{}
+				c.subscriptions[security.secId] = subscription
+			}
+
+			kind.apply(subscription)
+
+			if !subscription.allTrades && !subscription.quotations && !subscription.quotes {
+				delete(c.subscriptions, security.secId)
+			}
+		}
+	}
+
+	if enable {
+		for _, ref := range command.Quotes {
+			security := c.findSecurity(ref)
+			security.book = map[int64]simulatorLevel{}
+			c.emitBook(security)
+		}
+	}
+
+	return simulatorResultSuccess()
+}
+
+func (c *SimulatorConnector) newOrder(command *simulatorCommand) string {
+	security, price, rejection := c.orderTerms(command)
+	if rejection != "" {
+		return rejection
+	}
+
+	order := c.placeOrder(security, command.BuySell, price, command.Quantity, command.BrokerRef, command.ByMarket != nil)
+
+	return fmt.Sprintf("<result success=\"true\" transactionid=\"%d\"/>", order.transactionId)
+}
+
+// newCondOrder accepts the order, it watches its condition until cancelled.
+func (c *SimulatorConnector) newCondOrder(command *simulatorCommand) string {
+	security, price, rejection := c.orderTerms(command)
+	if rejection != "" {
+		return rejection
+	}
+	if command.CondType == "" {
+		return simulatorResultError("cond_type is required")
+	}
+
+	c.transactionId++
+	order := &simulatorOrder{
+		transactionId: c.transactionId,
+		security:      security,
+		buySell:       command.BuySell,
+		price:         price,
+		quantity:      command.Quantity,
+		balance:       command.Quantity,
+		brokerRef:     command.BrokerRef,
+		status:        "watching",
+		condition:     command.CondType,
+	}
+	c.watching = append(c.watching, order)
+	c.emit(c.orderMessage(order))
+
+	return fmt.Sprintf("<result success=\"true\" transactionid=\"%d\"/>", order.transactionId)
+}
+
+// newStopOrder accepts the order, its activation price is never reached.
+func (c *SimulatorConnector) newStopOrder(command *simulatorCommand) string {
+	security := c.findSecurity(command.Security)
+	if security == nil {
+		return simulatorResultError("Unknown security")
+	}
+	if command.BuySell != "B" && command.BuySell != "S" {
+		return simulatorResultError("buysell must be B or S")
+	}
+
+	leg, kind := command.StopLoss, "stoploss"
+	if leg == nil {
+		leg, kind = command.TakeProfit, "takeprofit"
+	}
+	if leg == nil {
+		return simulatorResultError("stoploss or takeprofit is required")
+	}
+	if leg.Quantity <= 0 {
+		return simulatorResultError("quantity must be positive")
+	}
+	activation, err := parseSimulatorPrice(leg.ActivationPrice, security.decimals)
+	if err != nil || activation <= 0 {
+		return simulatorResultError("activationprice is required")
+	}
+
+	c.transactionId++
+	order := &simulatorOrder{
+		transactionId: c.transactionId,
+		security:      security,
+		buySell:       command.BuySell,
+		quantity:      leg.Quantity,
+		balance:       leg.Quantity,
+		status:        "watching",
+		leg:           kind,
+		activation:    activation,
+	}
+	c.watching = append(c.watching, order)
+	c.emit(c.stopOrderMessage(order))
+
+	return fmt.Sprintf("<result success=\"true\" transactionid=\"%d\"/>", order.transactionId)
+}
+
+// orderTerms checks the terms neworder and newcondorder share, the rejection is
+// empty for valid ones.
+func (c *SimulatorConnector) orderTerms(command *simulatorCommand) (*simulatorSecurity, int64, string) {
+	ref := command.Security
+	if ref.SecId == 0 && ref.SecCode == "" {
+		ref.SecId = command.SecId
+	}
+
+	security := c.findSecurity(ref)
+	if security == nil {
+		return nil, 0, simulatorResultError("Unknown security")
+	}
+
+	if command.BuySell != "B" && command.BuySell != "S" {
+		return nil, 0, simulatorResultError("buysell must be B or S")
+	}
+
+	if command.Quantity <= 0 {
+		return nil, 0, simulatorResultError("quantity must be positive")
+	}
+
+	price := security.lastPrice
+	if command.ByMarket == nil {
+		parsed, err := parseSimulatorPrice(command.Price, security.decimals)
+		if err != nil || parsed <= 0 {
+			return nil, 0, simulatorResultError("price is required for limit order")
+		}
+		if parsed%security.minStep != 0 {
+			return nil, 0, simulatorResultError("price is not a multiple of minstep")
+		}
+		price = parsed
+	}
+
+	return security, price, ""
+}
+
+func (c *SimulatorConnector) placeOrder(
+	security *simulatorSecurity,
+	buySell string,
+	price int64,
+	quantity int64,
+	brokerRef string,
+	byMarket bool,
+) *simulatorOrder {
+	c.transactionId++
+	c.orderNo++
+
+	order := &simulatorOrder{
+		transactionId: c.transactionId,
+		orderNo:       c.orderNo,
+		security:      security,
+		buySell:       buySell,
+		price:         price,
+		quantity:      quantity,
+		balance:       quantity,
+		brokerRef:     brokerRef,
+		status:        "active",
+		condition:     "None",
+	}
+	c.emit(c.orderMessage(order))
+
+	if byMarket || c.isMarketable(order) {
+		c.fill(order, security.lastPrice)
+	} else {
+		c.orders = append(c.orders, order)
+	}
+
+	return order
+}
+
+func (c *SimulatorConnector) cancelOrder(command *simulatorCommand) string {
+	order := c.takeOrder(command.TransactionId)
+	if order == nil {
+		order = c.takeWatching(command.TransactionId, false)
+	}
+	if order == nil {
+		return simulatorResultError("Order is not found or already completed")
+	}
+
+	order.status = "cancelled"
+	c.emit(c.orderMessage(order))
+
+	return simulatorResultSuccess()
+}
+
+func (c *SimulatorConnector) cancelStopOrder(command *simulatorCommand) string {
+	order := c.takeWatching(command.TransactionId, true)
+	if order == nil {
+		return simulatorResultError("Stop order is not found or already completed")
+	}
+
+	order.status = "cancelled"
+	c.emit(c.stopOrderMessage(order))
+
+	return simulatorResultSuccess()
+}
+
+// moveOrder cancels the order and places a new one at the new price, as the
+// real connector does.
+func (c *SimulatorConnector) moveOrder(command *simulatorCommand) string {
+	var order *simulatorOrder
+	for _, active := range c.orders {
+		if active.transactionId == command.TransactionId {
+			order = active
+		}
+	}
+	if order == nil {
+		return simulatorResultError("Order is not found or already completed")
+	}
+
+	price, err := parseSimulatorPrice(command.Price, order.security.decimals)
+	if err != nil || price <= 0 || price%order.security.minStep != 0 {
+		return simulatorResultError("price is not a multiple of minstep")
+	}
+
+	quantity := order.balance
+	if command.MoveFlag == 1 || (command.MoveFlag == 2 && order.balance == order.quantity) {
+		quantity = command.Quantity
+	}
+
+	c.takeOrder(order.transactionId)
+	order.status = "cancelled"
+	c.emit(c.orderMessage(order))
+
+	moved := c.placeOrder(order.security, order.buySell, price, quantity, order.brokerRef, false)
+
+	return fmt.Sprintf("<result success=\"true\" transactionid=\"%d\"/>", moved.transactionId)
+}
+
+// takeOrder removes an active order from the book.
+func (c *SimulatorConnector) takeOrder(transactionId int64) *simulatorOrder {
+	for i, order := range c.orders {
+		if order.transactionId == transactionId {
+			c.orders = append(c.orders[:i], c.orders[i+1:]...)
+			return order
+		}
+	}
+
+	return nil
+}
+
+// takeWatching removes a conditional or a stop order from the watched ones.
+func (c *SimulatorConnector) takeWatching(transactionId int64, stop bool) *simulatorOrder {
+	for i, order := range c.watching {
+		if order.transactionId == transactionId && (order.leg != "") == stop {
+			c.watching = append(c.watching[:i], c.watching[i+1:]...)
+			return order
+		}
+	}
+
+	return nil
+}
+
+func (c *SimulatorConnector) isMarketable(order *simulatorOrder) bool {
+	if order.buySell == "B" {
+		return order.price >= order.security.lastPrice
+	}
+
+	return order.price <= order.security.lastPrice
+}
+
+func (c *SimulatorConnector) fill(order *simulatorOrder, price int64) {
+	c.tradeNo++
+	security := order.security
+
+	c.emit(fmt.Sprintf(
+		"<trades><trade><secid>%d</secid><tradeno>%d</tradeno><orderno>%d</orderno><board>%s</board>"+
+			"<seccode>%s</seccode><client>%s</client><union>%s</union><buysell>%s</buysell><time>%s</time>"+
+			"<brokerref>%s</brokerref><value>%s</value><comission>0</comission><price>%s</price>"+
+			"<quantity>%d</quantity><items>%d</items><tradetype>T</tradetype><settlecode>Y2</settlecode>"+
+			"<currentpos>%d</currentpos></trade></trades>",
+		security.secId, c.tradeNo, order.orderNo, security.board, security.secCode, simulatorClientId,
+		simulatorUnion, order.buySell, simulatorNow().Format(simulatorDateLayout), escapeSimulatorText(order.brokerRef),
+		formatSimulatorPrice(price*order.balance*security.lotSize, security.decimals),
+		formatSimulatorPrice(price, security.decimals), order.balance, order.balance*security.lotSize,
+		order.balance*security.lotSize,
+	))
+
+	order.balance = 0
+	order.status = "matched"
+	c.emit(c.orderMessage(order))
+}
+
+func (c *SimulatorConnector) runCallbacks(ctx context.Context, events <-chan string) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-events:
+			c.callback(msg)
+		}
+	}
+}
+
+func (c *SimulatorConnector) runMarket(ctx context.Context) {
+	ticker := time.NewTicker(simulatorTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.tick()
+		}
+	}
+}
+
+func (c *SimulatorConnector) tick() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.connected {
+		return
+	}
+
+	for _, security := range c.securities {
+		subscription, ok := c.subscriptions[security.secId]
+		if !ok {
+			continue
+		}
+
+		security.lastPrice += security.minStep * int64(c.random.Intn(5)-2)
+		if security.lastPrice < security.minStep {
+			security.lastPrice = security.minStep
+		}
+
+		quantity := int64(c.random.Intn(20) + 1)
+		buySell := "B"
+		if c.random.Intn(2) == 0 {
+			buySell = "S"
+		}
+		security.numTrades++
+		security.volToday += quantity
+		c.tradeNo++
+
+		if subscription.allTrades {
+			c.emit(fmt.Sprintf(
+				"<alltrades><trade secid=\"%d\"><seccode>%s</seccode><board>%s</board><tradeno>%d</tradeno>"+
+					"<time>%s</time><price>%s</price><quantity>%d</quantity><buysell>%s</buysell>"+
+					"<period>N</period></trade></alltrades>",
+				security.secId, security.secCode, security.board, c.tradeNo,
+				simulatorNow().Format(simulatorDateLayout), formatSimulatorPrice(security.lastPrice, security.decimals),
+				quantity, buySell,
+			))
+		}
+
+		if subscription.quotations {
+			c.emit(fmt.Sprintf(
+				"<quotations><quotation secid=\"%d\"><board>%s</board><seccode>%s</seccode><last>%s</last>"+
+					"<quantity>%d</quantity><time>%s</time><change>%s</change><bid>%s</bid><offer>%s</offer>"+
+					"<numtrades>%d</numtrades><voltoday>%d</voltoday><status>A</status>"+
+					"<tradingstatus>T</tradingstatus></quotation></quotations>",
+				security.secId, security.board, security.secCode,
+				formatSimulatorPrice(security.lastPrice, security.decimals), quantity,
+				simulatorNow().Format(simulatorTimeLayout),
+				formatSimulatorPrice(security.lastPrice-security.openPrice, security.decimals),
+				formatSimulatorPrice(security.lastPrice-security.minStep, security.decimals),
+				formatSimulatorPrice(security.lastPrice+security.minStep, security.decimals),
+				security.numTrades, security.volToday,
+			))
+		}
+
+		if subscription.quotes {
+			c.emitBook(security)
+		}
+	}
+
+	active := c.orders[:0]
+	for _, order := range c.orders {
+		if c.isMarketable(order) {
+			c.fill(order, order.price)
+			continue
+		}
+		active = append(active, order)
+	}
+	c.orders = active
+}
+
+// emitBook sends the difference between the previous and the current order book,
+// removed levels are reported with -1 as the real connector does.
+func (c *SimulatorConnector) emitBook(security *simulatorSecurity) {
+	book := map[int64]simulatorLevel{}
+	for i := int64(1); i <= simulatorBookDepth; i++ {
+		book[security.lastPrice-security.minStep*i] = simulatorLevel{buy: int64(c.random.Intn(500) + 1)}
+		book[security.lastPrice+security.minStep*i] = simulatorLevel{sell: int64(c.random.Intn(500) + 1)}
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString("<quotes>")
+	writeQuote := func(price int64, side string, volume int64) {
+		builder.WriteString(fmt.Sprintf(
+			"<quote secid=\"%d\"><board>%s</board><seccode>%s</seccode><price>%s</price><source>%s</source>"+
+				"<%s>%d</%s></quote>",
+			security.secId, security.board, security.secCode, formatSimulatorPrice(price, security.decimals),
+			simulatorSource, side, volume, side,
+		))
+	}
+
+	for price, level := range security.book {
+		current, ok := book[price]
+		if level.buy > 0 && (!ok || current.buy == 0) {
+			writeQuote(price, "buy", -1)
+		}
+		if level.sell > 0 && (!ok || current.sell == 0) {
+			writeQuote(price, "sell", -1)
+		}
+	}
+
+	for price, level := range book {
+		if level.buy > 0 {
+			writeQuote(price, "buy", level.buy)
+		}
+		if level.sell > 0 {
+			writeQuote(price, "sell", level.sell)
+		}
+	}
+
+	builder.WriteString("</quotes>")
+	security.book = book
+
+	c.emit(builder.String())
+}
+
+func (c *SimulatorConnector) emit(msg string) {
+	select {
+	case c.events <- msg:
+	default:
+		c.localLogger.Warn().Msg("simulator events channel overflow")
+	}
+}
+
+func (c *SimulatorConnector) findSecurity(ref simulatorSecurityRef) *simulatorSecurity {
+	for _, security := range c.securities {
+		if ref.SecId != 0 && security.secId == ref.SecId {
+			return security
+		}
+		if ref.SecCode == security.secCode && (ref.Board == "" || ref.Board == security.board) {
+			return security
+		}
+	}
+
+	return nil
+}
+
+func (c *SimulatorConnector) serverStatus() string {
+	return fmt.Sprintf(
+		"<server_status id=\"1\" connected=\"%t\" recover=\"false\" server_tz=\"Russian Standard Time\"/>",
+		c.connected,
+	)
+}
+
+func (c *SimulatorConnector) marketsMessage() string {
+	return "<markets><market id=\"1\">MICEX</market><market id=\"4\">FORTS</market></markets>"
+}
+
+func (c *SimulatorConnector) boardsMessage() string {
+	return "<boards>" +
+		"<board id=\"TQBR\"><name>Т+: Акции и ДР</name><market>1</market><type>1</type></board>" +
+		"<board id=\"FUT\"><name>ФОРТС: Фьючерсы</name><market>4</market><type>1</type></board>" +
+		"</boards>"
+}
+
+func (c *SimulatorConnector) securitiesMessage() string {
+	builder := strings.Builder{}
+	builder.WriteString("<securities>")
+
+	for _, security := range c.securities {
+		builder.WriteString(fmt.Sprintf(
+			"<security secid=\"%d\" active=\"true\"><seccode>%s</seccode><instrclass>%s</instrclass>"+
+				"<board>%s</board><market>%d</market><currency>RUB</currency><shortname>%s</shortname>"+
+				"<decimals>%d</decimals><minstep>%s</minstep><lotsize>%d</lotsize><lotdivider>1</lotdivider>"+
+				"<point_cost>1</point_cost><opmask usecredit=\"yes\" bymarket=\"yes\" nosplit=\"yes\" "+
+				"fok=\"yes\" ioc=\"yes\"/><sectype>%s</sectype><sec_tz>Russian Standard Time</sec_tz>"+
+				"<quotestype>1</quotestype><MIC>MISX</MIC></security>",
+			security.secId, security.secCode, security.instrClass, security.board, security.market,
+			escapeSimulatorText(security.shortName), security.decimals,
+			formatSimulatorPrice(security.minStep, security.decimals), security.lotSize, security.secType,
+		))
+	}
+
+	builder.WriteString("</securities>")
+
+	return builder.String()
+}
+
+func (c *SimulatorConnector) clientMessage() string {
+	return fmt.Sprintf(
+		"<client id=\"%s\" remove=\"false\"><type>mct</type><currency>RUB</currency><market>1</market>"+
+			"<market>4</market><union>%s</union><forts_acc>SIMF001</forts_acc></client>",
+		simulatorClientId, simulatorUnion,
+	)
+}
+
+func (c *SimulatorConnector) positionsMessage() string {
+	return fmt.Sprintf(
+		"<positions><money_position><asset>FOND_MICEX</asset><client>%s</client><union>%s</union>"+
+			"<markets><market>1</market></markets><register>T0</register><shortname>Рубли РФ</shortname>"+
+			"<saldoin>1000000.00</saldoin><bought>0.00</bought><sold>0.00</sold><saldo>1000000.00</saldo>"+
+			"<ordbuy>0.00</ordbuy><ordbuycond>0.00</ordbuycond><comission>0.00</comission></money_position>"+
+			"</positions>",
+		simulatorClientId, simulatorUnion,
+	)
+}
+
+func (c *SimulatorConnector) orderMessage(order *simulatorOrder) string {
+	security := order.security
+
+	return fmt.Sprintf(
+		"<orders><order transactionid=\"%d\"><orderno>%d</orderno><secid>%d</secid><board>%s</board>"+
+			"<seccode>%s</seccode><client>%s</client><union>%s</union><status>%s</status><buysell>%s</buysell>"+
+			"<time>%s</time><brokerref>%s</brokerref><value>%s</value><accruedint>0</accruedint>"+
+			"<settlecode>Y2</settlecode><balance>%d</balance><price>%s</price><quantity>%d</quantity>"+
+			"<hidden>0</hidden><yield>0</yield><withdrawtime>0</withdrawtime><condition>%s</condition>"+
+			"<maxcomission>0</maxcomission><result/></order></orders>",
+		order.transactionId, order.orderNo, security.secId, security.board, security.secCode, simulatorClientId,
+		simulatorUnion, order.status, order.buySell, simulatorNow().Format(simulatorDateLayout),
+		escapeSimulatorText(order.brokerRef),
+		formatSimulatorPrice(order.price*order.quantity*security.lotSize, security.decimals), order.balance,
+		formatSimulatorPrice(order.price, security.decimals), order.quantity, order.condition,
+	)
+}
+
+func (c *SimulatorConnector) stopOrderMessage(order *simulatorOrder) string {
+	security := order.security
+
+	return fmt.Sprintf(
+		"<orders><stoporder transactionid=\"%d\"><secid>%d</secid><board>%s</board><seccode>%s</seccode>"+
+			"<client>%s</client><union>%s</union><buysell>%s</buysell><status>%s</status>"+
+			"<%s><activationprice>%s</activationprice><quantity>%d</quantity></%s></stoporder></orders>",
+		order.transactionId, security.secId, security.board, security.secCode, simulatorClientId, simulatorUnion,
+		order.buySell, order.status, order.leg, formatSimulatorPrice(order.activation, security.decimals),
+		order.quantity, order.leg,
+	)
+}
+
+const simulatorSource = "MICEX"
+
+const simulatorCandleKinds = "<candlekinds>" +
+	"<kind><id>1</id><period>60</period><name>1 minute</name></kind>" +
+	"<kind><id>2</id><period>300</period><name>5 minutes</name></kind>" +
+	"<kind><id>3</id><period>900</period><name>15 minutes</name></kind>" +
+	"<kind><id>4</id><period>3600</period><name>1 hour</name></kind>" +
+	"<kind><id>5</id><period>86400</period><name>1 day</name></kind>" +
+	"</candlekinds>"
+
+func newSimulatorSecurities() []*simulatorSecurity {
+	securities := []*simulatorSecurity{
+		{secId: 1, secCode: "SBER", board: "TQBR", market: 1, shortName: "Сбербанк", secType: "SHARE", instrClass: "E", decimals: 2, minStep: 1, lotSize: 10, openPrice: 25000},
+		{secId: 2, secCode: "GAZP", board: "TQBR", market: 1, shortName: "ГАЗПРОМ ао", secType: "SHARE", instrClass: "E", decimals: 2, minStep: 1, lotSize: 10, openPrice: 16500},
+		{secId: 3, secCode: "LKOH", board: "TQBR", market: 1, shortName: "ЛУКОЙЛ", secType: "SHARE", instrClass: "E", decimals: 1, minStep: 5, lotSize: 1, openPrice: 68000},
+		{secId: 4, secCode: "SiZ6", board: "FUT", market: 4, shortName: "Si-12.26", secType: "FUT", instrClass: "F", decimals: 0, minStep: 1, lotSize: 1, openPrice: 92000},
+	}
+
+	for _, security := range securities {
+		security.lastPrice = security.openPrice
+		security.book = map[int64]simulatorLevel{}
+	}
+
+	return securities
+}
+
+func simulatorResultSuccess() string {
+	return "<result success=\"true\"/>"
+}
+
+func simulatorResultError(msg string) string {
+	return "<result success=\"false\"><message>" + escapeSimulatorText(msg) + "</message></result>"
+}
+
+func simulatorNow() time.Time {
+	return time.Now().In(simulatorLocation)
+}
+
+func escapeSimulatorText(text string) string {
+	buffer := bytes.Buffer{}
+	_ = xml.EscapeText(&buffer, []byte(text))
+
+	return buffer.String()
+}
+
+func formatSimulatorPrice(value int64, decimals int) string {
+	if decimals == 0 {
+		return fmt.Sprintf("%d", value)
+	}
+
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	divider := int64(1)
+	for i := 0; i < decimals; i++ {
+		divider *= 10
+	}
+
+	return fmt.Sprintf("%s%d.%0*d", sign, value/divider, decimals, value%divider)
+}
+
+func parseSimulatorPrice(value string, decimals int) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, errors.New("empty price")
+	}
+
+	integer, fraction, _ := strings.Cut(value, ".")
+	if len(fraction) > decimals {
+		return 0, errors.New("too many decimals")
+	}
+	fraction += strings.Repeat("0", decimals-len(fraction))
+
+	return strconv.ParseInt(integer+fraction, 10, 64)
+}
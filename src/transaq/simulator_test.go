@@ -0,0 +1,248 @@
+package transaq
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startSimulator returns the simulator with its callbacks passed to received.
+func startSimulator(t *testing.T) (*SimulatorConnector, <-chan string) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	connector := NewSimulatorConnector(&logger)
+	received := make(chan string, simulatorEventsSize)
+	connector.SetCallback(func(msg string) {
+		received <- msg
+	})
+
+	err := connector.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(connector.Release)
+
+	return connector, received
+}
+
+func sendSimulator(t *testing.T, connector *SimulatorConnector, command commands.Command) *commands.Result {
+	t.Helper()
+
+	msg, err := commands.Marshal(command)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sendSimulatorRaw(t, connector, msg)
+}
+
+func sendSimulatorRaw(t *testing.T, connector *SimulatorConnector, msg string) *commands.Result {
+	t.Helper()
+
+	resp, _, err := connector.SendCommand(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := commands.ParseResult(resp, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
+
+func expectRejected(t *testing.T, result *commands.Result, message string) {
+	t.Helper()
+
+	if result.Success || !strings.Contains(result.Message, message) {
+		t.Fatalf("got %+v, want a rejection with %q", result, message)
+	}
+}
+
+// nextCallback returns the next callback message, it must contain every part.
+func nextCallback(t *testing.T, received <-chan string, root string, parts ...string) string {
+	t.Helper()
+
+	select {
+	case msg := <-received:
+		if messages.RootOf(msg) != root {
+			t.Fatalf("got %s, want %s", msg, root)
+		}
+		for _, part := range parts {
+			if !strings.Contains(msg, part) {
+				t.Fatalf("got %s, want %s in it", msg, part)
+			}
+		}
+		return msg
+	case <-time.After(time.Second):
+		t.Fatalf("no %s", root)
+		return ""
+	}
+}
+
+func expectNoCallback(t *testing.T, received <-chan string) {
+	t.Helper()
+
+	select {
+	case msg := <-received:
+		t.Fatalf("got %s", msg)
+	case <-time.After(time.Millisecond * 20):
+	}
+}
+
+func connectSimulator(t *testing.T) (*SimulatorConnector, <-chan string) {
+	t.Helper()
+
+	connector, received := startSimulator(t)
+	if result := sendSimulator(t, connector, testConnect("host")); !result.Success {
+		t.Fatalf("connect failed: %+v", result)
+	}
+	for _, root := range []string{
+		messages.RootMarkets, messages.RootBoards, messages.RootCandleKinds, messages.RootSecurities,
+		messages.RootClient, messages.RootPositions, messages.RootServerStatus,
+	} {
+		nextCallback(t, received, root)
+	}
+
+	return connector, received
+}
+
+func TestSimulatorConnect(t *testing.T) {
+	connector, received := startSimulator(t)
+
+	expectRejected(t, sendSimulator(t, connector, commands.ServerStatus{}), "Not connected")
+	expectRejected(t, sendSimulatorRaw(t, connector, `<command id="connect"><login>login</login></command>`), "required")
+	expectNoCallback(t, received)
+
+	result := sendSimulator(t, connector, testConnect("host"))
+	if !result.Success {
+		t.Fatalf("connect failed: %+v", result)
+	}
+
+	// the reference data comes before the session is reported connected
+	nextCallback(t, received, messages.RootMarkets, `<market id="1">MICEX</market>`)
+	nextCallback(t, received, messages.RootBoards, `<board id="TQBR">`)
+	nextCallback(t, received, messages.RootCandleKinds)
+	nextCallback(t, received, messages.RootSecurities, "<seccode>SBER</seccode>", "<seccode>SiZ6</seccode>")
+	nextCallback(t, received, messages.RootClient, `id="SIM0001"`)
+	nextCallback(t, received, messages.RootPositions, "<money_position>")
+	nextCallback(t, received, messages.RootServerStatus, `connected="true"`)
+
+	expectRejected(t, sendSimulator(t, connector, testConnect("host")), "Already connected")
+
+	// server_status is answered with the message
+	if result := sendSimulator(t, connector, commands.ServerStatus{}); !result.Success {
+		t.Fatalf("server_status failed: %+v", result)
+	}
+	nextCallback(t, received, messages.RootServerStatus, `connected="true"`)
+
+	expectRejected(t, sendSimulator(t, connector, commands.GetServTimeDifference{}), "not supported")
+
+	if result := sendSimulatorRaw(t, connector, `<command id="neworder"><quantity>x</quantity></command>`); !result.ErrorResponse {
+		t.Fatalf("got %+v for a malformed command, want <error>", result)
+	}
+
+	if result := sendSimulator(t, connector, commands.Disconnect{}); !result.Success {
+		t.Fatalf("disconnect failed: %+v", result)
+	}
+	nextCallback(t, received, messages.RootServerStatus, `connected="false"`)
+	expectRejected(t, sendSimulator(t, connector, commands.ServerStatus{}), "Not connected")
+}
+
+func TestSimulatorSubscribe(t *testing.T) {
+	connector, received := connectSimulator(t)
+
+	expectRejected(t, sendSimulator(t, connector, commands.Subscribe{
+		Quotes: commands.SecurityList{{Board: "TQBR", SecCode: "NONE"}},
+	}), "Unknown security")
+
+	// the order book is sent right away, quotations with the next tick
+	result := sendSimulator(t, connector, commands.Subscribe{
+		Quotations: commands.SecurityList{{Board: "TQBR", SecCode: "SBER"}},
+		Quotes:     commands.SecurityList{{Board: "TQBR", SecCode: "SBER"}},
+	})
+	if !result.Success {
+		t.Fatalf("subscribe failed: %+v", result)
+	}
+	nextCallback(t, received, messages.RootQuotes, "<seccode>SBER</seccode>", "<buy>", "<sell>")
+
+	if got := connector.quotations(); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("quotations of %v streamed, want sber", got)
+	}
+}
+
+func TestSimulatorOrderFlow(t *testing.T) {
+	connector, received := connectSimulator(t)
+
+	order := func(price string) commands.NewOrder {
+		command := commands.NewOrder{
+			Security: commands.SecurityRef{Board: "TQBR", SecCode: "SBER"},
+			Client:   simulatorClientId,
+			Quantity: 2,
+			BuySell:  messages.Buy,
+		}
+		if price == "" {
+			command.ByMarket = true
+		} else {
+			parsed := decimal.RequireFromString(price)
+			command.Price = &parsed
+		}
+		return command
+	}
+
+	// below the market, the order waits in the book
+	result := sendSimulator(t, connector, order("1.00"))
+	if !result.Success || result.TransactionId != 1 {
+		t.Fatalf("got %+v, want transaction 1", result)
+	}
+	nextCallback(t, received, messages.RootOrders, `transactionid="1"`, "<status>active</status>", "<price>1.00</price>")
+
+	// above the market, filled at the last price
+	result = sendSimulator(t, connector, order("300.00"))
+	if !result.Success || result.TransactionId != 2 {
+		t.Fatalf("got %+v, want transaction 2", result)
+	}
+	nextCallback(t, received, messages.RootOrders, `transactionid="2"`, "<status>active</status>")
+	nextCallback(t, received, messages.RootTrades, "<price>250.00</price>", "<quantity>2</quantity>", "<items>20</items>")
+	nextCallback(t, received, messages.RootOrders, `transactionid="2"`, "<status>matched</status>", "<balance>0</balance>")
+
+	// by market
+	result = sendSimulator(t, connector, order(""))
+	if !result.Success {
+		t.Fatalf("got %+v", result)
+	}
+	nextCallback(t, received, messages.RootOrders, `transactionid="3"`, "<status>active</status>")
+	nextCallback(t, received, messages.RootTrades)
+	nextCallback(t, received, messages.RootOrders, `transactionid="3"`, "<status>matched</status>")
+
+	// moved, the order is replaced by a new one
+	result = sendSimulator(t, connector, commands.MoveOrder{TransactionId: 1, Price: decimal.RequireFromString("2.00")})
+	if !result.Success || result.TransactionId != 4 {
+		t.Fatalf("got %+v, want transaction 4", result)
+	}
+	nextCallback(t, received, messages.RootOrders, `transactionid="1"`, "<status>cancelled</status>")
+	nextCallback(t, received, messages.RootOrders, `transactionid="4"`, "<status>active</status>", "<price>2.00</price>")
+	expectRejected(t, sendSimulator(t, connector, commands.MoveOrder{TransactionId: 1, Price: decimal.RequireFromString("3.00")}), "not found")
+
+	result = sendSimulator(t, connector, commands.CancelOrder{TransactionId: 4})
+	if !result.Success {
+		t.Fatalf("got %+v", result)
+	}
+	nextCallback(t, received, messages.RootOrders, `transactionid="4"`, "<status>cancelled</status>")
+
+	// filled and cancelled orders are gone
+	expectRejected(t, sendSimulator(t, connector, commands.CancelOrder{TransactionId: 4}), "not found")
+	expectRejected(t, sendSimulator(t, connector, commands.CancelOrder{TransactionId: 2}), "not found")
+	expectNoCallback(t, received)
+
+	rejected := order("1.00")
+	rejected.Security.SecCode = "NONE"
+	expectRejected(t, sendSimulator(t, connector, rejected), "Unknown security")
+	expectRejected(t, sendSimulator(t, connector, order("1.001")), "price is required")
+}
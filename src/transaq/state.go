@@ -0,0 +1,91 @@
+package transaq
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// StateError is returned for commands that can not succeed in the current connection state.
+type StateError struct {
+	State   ConnectionState
+	Command string
+}
+
+func (e *StateError) Error() string {
+	switch e.State {
+	case ConnectionUninitialized:
+		return fmt.Sprintf("command %s rejected: connector is not initialized", e.Command)
+	case ConnectionConnecting:
+		return fmt.Sprintf("command %s rejected: connection is in progress", e.Command)
+	case ConnectionConnected, ConnectionRecovering:
+		if e.Command == "connect" {
+			return "command connect rejected: already connected"
+		}
+	case ConnectionError:
+		if e.Command == "connect" {
+			return "command connect rejected: disconnect is required after a connection error"
+		}
+	}
+
+	return fmt.Sprintf("command %s rejected: connection is %s", e.Command, e.State)
+}
+
+// checkCommand tells whether the command is worth sending to the connector in the given state.
+func checkCommand(state ConnectionState, command string) error {
+	if state == ConnectionUninitialized {
+		return &StateError{State: state, Command: command}
+	}
+
+	allowed := true
+	switch command {
+	case "":
+		// unknown payload, the connector answers with its own error
+	case "connect":
+		allowed = state == ConnectionDisconnected
+	case "disconnect":
+		allowed = state == ConnectionConnecting || state == ConnectionConnected ||
+			state == ConnectionRecovering || state == ConnectionError
+	case "server_status":
+		// Transaq has no server to ask before the session is up
+		allowed = state == ConnectionConnected || state == ConnectionRecovering
+	case "get_connector_version":
+		// session independent
+	default:
+		allowed = state == ConnectionConnected
+	}
+
+	if !allowed {
+		return &StateError{State: state, Command: command}
+	}
+
+	return nil
+}
+
+// commandId reads the id attribute of the root command element.
+func commandId(command string) string {
+	decoder := xml.NewDecoder(strings.NewReader(command))
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if element.Name.Local != "command" {
+			return ""
+		}
+
+		for _, attr := range element.Attr {
+			if attr.Name.Local == "id" {
+				return attr.Value
+			}
+		}
+
+		return ""
+	}
+}
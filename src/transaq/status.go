@@ -0,0 +1,76 @@
+package transaq
+
+import (
+	"github.com/TrueGameover/transaq-grpc/src/messages"
+	"time"
+)
+
+type ConnectionState int
+
+const (
+	ConnectionUnknown ConnectionState = iota
+	ConnectionConnected
+	ConnectionRecovering
+	ConnectionDisconnected
+	ConnectionError
+	// dll is not loaded or already released
+	ConnectionUninitialized
+	// connect is accepted, waiting for server_status
+	ConnectionConnecting
+	// disconnect is accepted, waiting for server_status
+	ConnectionDisconnecting
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionConnected:
+		return "connected"
+	case ConnectionRecovering:
+		return "recovering"
+	case ConnectionDisconnected:
+		return "disconnected"
+	case ConnectionError:
+		return "error"
+	case ConnectionUninitialized:
+		return "uninitialized"
+	case ConnectionConnecting:
+		return "connecting"
+	case ConnectionDisconnecting:
+		return "disconnecting"
+	}
+
+	return "unknown"
+}
+
+// ConnectionStatus is published on every transition and on every server_status,
+// the latter may repeat the current state.
+type ConnectionStatus struct {
+	State    ConnectionState
+	Previous ConnectionState
+	Error    string
+	ServerId int
+	ServerTz string
+	Time     time.Time
+}
+
+func connectionStatusFrom(msg *messages.ServerStatus) ConnectionStatus {
+	status := ConnectionStatus{
+		ServerId: msg.Id,
+		ServerTz: msg.ServerTz,
+		Time:     time.Now(),
+	}
+
+	switch {
+	case msg.Connected == messages.ConnectionError:
+		status.State = ConnectionError
+		status.Error = msg.Text
+	case msg.Recover:
+		status.State = ConnectionRecovering
+	case msg.Connected == messages.ConnectionConnected:
+		status.State = ConnectionConnected
+	default:
+		status.State = ConnectionDisconnected
+	}
+
+	return status
+}
@@ -0,0 +1,343 @@
+package transaq
+
+import (
+	"context"
+	"errors"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/rs/zerolog"
+	"sort"
+	"sync"
+)
+
+type MarketDataKind string
+
+const (
+	MarketDataAllTrades  MarketDataKind = "alltrades"
+	MarketDataQuotations MarketDataKind = "quotations"
+	MarketDataQuotes     MarketDataKind = "quotes"
+)
+
+// MarketDataStream is one kind of market data of one security.
+type MarketDataStream struct {
+	Kind     MarketDataKind
+	Security commands.SecurityRef
+}
+
+var ErrUnknownSubscriber = errors.New("subscriber has no open data stream")
+
+func subscribeCommand(streams []MarketDataStream) commands.Subscribe {
+	command := commands.Subscribe{}
+	for _, stream := range streams {
+		switch stream.Kind {
+		case MarketDataAllTrades:
+			command.AllTrades = append(command.AllTrades, stream.Security)
+		case MarketDataQuotations:
+			command.Quotations = append(command.Quotations, stream.Security)
+		case MarketDataQuotes:
+			command.Quotes = append(command.Quotes, stream.Security)
+		}
+	}
+
+	return command
+}
+
+// SubscriptionManager shares Transaq subscriptions between clients. Every
+// subscriber holds its own set of streams, subscribe and unsubscribe reach
+// Transaq only when the first subscriber comes or the last one leaves. The set
+// lives while the subscriber has an open data stream. An unsubscribe that
+// failed is sent again with the next change until the session ends.
+type SubscriptionManager struct {
+	mutex *sync.Mutex
+	// serializes the commands, so that an unsubscribe can not overtake a
+	// subscribe of the same stream, taken before the mutex
+	commandMutex *sync.Mutex
+	handler      *TransaqHandler
+	// subscribers per stream
+	counts map[MarketDataStream]int
+	// nobody holds them anymore, Transaq streams them until an unsubscribe succeeds
+	released map[MarketDataStream]struct{}
+	// open data streams per subscriber
+	attached    map[string]int
+	subscribers map[string]map[MarketDataStream]struct{}
+	// a client disconnected the session, Transaq forgot the subscriptions
+	stale       bool
+	localLogger *zerolog.Logger
+}
+
+func NewSubscriptionManager(logger *zerolog.Logger, handler *TransaqHandler) *SubscriptionManager {
+	localLogger := logger.With().Str("Service", "SubscriptionManager").Logger()
+
+	m := &SubscriptionManager{
+		mutex:        &sync.Mutex{},
+		commandMutex: &sync.Mutex{},
+		handler:      handler,
+		counts:       map[MarketDataStream]int{},
+		released:     map[MarketDataStream]struct{}{},
+		attached:     map[string]int{},
+		subscribers:  map[string]map[MarketDataStream]struct{}{},
+		localLogger:  &localLogger,
+	}
+	handler.AddCommandObserver(m.observeCommand)
+
+	return m
+}
+
+// Run restores the subscriptions when a session is connected again after a
+// disconnect command, the Supervisor restores them after a lost session.
+func (m *SubscriptionManager) Run(ctx context.Context) {
+	statuses := m.handler.WatchConnectionStatus(ctx)
+
+	for status := range statuses {
+		if status.State != ConnectionConnected {
+			continue
+		}
+
+		m.mutex.Lock()
+		stale := m.stale
+		m.mutex.Unlock()
+
+		if stale {
+			m.Restore()
+		}
+	}
+}
+
+// Restore subscribes to every held stream again, Transaq forgets the
+// subscriptions with the session.
+func (m *SubscriptionManager) Restore() {
+	m.commandMutex.Lock()
+	defer m.commandMutex.Unlock()
+
+	m.mutex.Lock()
+	m.stale = false
+	m.released = map[MarketDataStream]struct{}{}
+	streams := make([]MarketDataStream, 0, len(m.counts))
+	for stream := range m.counts {
+		streams = append(streams, stream)
+	}
+	m.mutex.Unlock()
+
+	if len(streams) == 0 {
+		return
+	}
+
+	_, err := m.handler.send(subscribeCommand(streams), false)
+	if err != nil {
+		m.localLogger.Error().Err(err).Msg("Subscriptions restore failed")
+		return
+	}
+
+	m.localLogger.Info().Msgf("Restored %d subscriptions", len(streams))
+}
+
+// observeCommand is called from the commands of clients, the manager never
+// sends disconnect.
+func (m *SubscriptionManager) observeCommand(command string, result *commands.Result) {
+	if result.Success && commandId(command) == "disconnect" {
+		m.mutex.Lock()
+		m.stale = true
+		m.released = map[MarketDataStream]struct{}{}
+		m.mutex.Unlock()
+	}
+}
+
+// Attach registers an open data stream of the subscriber.
+func (m *SubscriptionManager) Attach(subscriber string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.attached[subscriber]++
+}
+
+// Detach unsubscribes everything the subscriber holds when its last data stream ends.
+func (m *SubscriptionManager) Detach(subscriber string) {
+	m.commandMutex.Lock()
+	defer m.commandMutex.Unlock()
+
+	m.mutex.Lock()
+	m.attached[subscriber]--
+	if m.attached[subscriber] > 0 {
+		m.mutex.Unlock()
+		return
+	}
+	delete(m.attached, subscriber)
+
+	held := m.subscribers[subscriber]
+	delete(m.subscribers, subscriber)
+	streams := make([]MarketDataStream, 0, len(held))
+	for stream := range held {
+		streams = append(streams, stream)
+	}
+	m.release(streams)
+	m.mutex.Unlock()
+
+	if len(streams) == 0 {
+		return
+	}
+
+	err := m.unsubscribeReleased()
+	if err != nil {
+		m.localLogger.Warn().Err(err).Msgf("Unsubscribe after %s left failed", subscriber)
+		return
+	}
+
+	m.localLogger.Info().Msgf("Released %d subscriptions of %s", len(streams), subscriber)
+}
+
+// Subscribe adds the streams to the subscriber set and returns the whole set.
+func (m *SubscriptionManager) Subscribe(subscriber string, streams []MarketDataStream) ([]MarketDataStream, error) {
+	m.commandMutex.Lock()
+	defer m.commandMutex.Unlock()
+
+	m.mutex.Lock()
+	if m.attached[subscriber] == 0 {
+		m.mutex.Unlock()
+		return nil, ErrUnknownSubscriber
+	}
+
+	held := m.subscribers[subscriber]
+	if held == nil {
+		held = map[MarketDataStream]struct{}{}
+		m.subscribers[subscriber] = held
+	}
+
+	var added, first []MarketDataStream
+	for _, stream := range streams {
+		if _, ok := held[stream]; ok {
+			continue
+		}
+
+		held[stream] = struct{}{}
+		added = append(added, stream)
+		if m.counts[stream] == 0 {
+			first = append(first, stream)
+			delete(m.released, stream)
+		}
+		m.counts[stream]++
+	}
+	m.mutex.Unlock()
+
+	if len(first) > 0 {
+		_, err := m.handler.Send(subscribeCommand(first))
+		if err != nil {
+			m.mutex.Lock()
+			for _, stream := range added {
+				delete(held, stream)
+				m.decrement(stream)
+			}
+			m.mutex.Unlock()
+			return nil, err
+		}
+	}
+
+	// a previous failure is retried, the subscribe succeeded anyway
+	err := m.unsubscribeReleased()
+	if err != nil {
+		m.localLogger.Warn().Err(err).Msg("Queued unsubscribe failed")
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return sortedStreams(held), nil
+}
+
+// Unsubscribe removes the streams from the subscriber set and returns the rest
+// of the set. The set is changed even if Transaq rejects the unsubscribe, it is
+// sent again with the next change.
+func (m *SubscriptionManager) Unsubscribe(subscriber string, streams []MarketDataStream) ([]MarketDataStream, error) {
+	m.commandMutex.Lock()
+	defer m.commandMutex.Unlock()
+
+	m.mutex.Lock()
+	if m.attached[subscriber] == 0 {
+		m.mutex.Unlock()
+		return nil, ErrUnknownSubscriber
+	}
+
+	held := m.subscribers[subscriber]
+	var removed []MarketDataStream
+	for _, stream := range streams {
+		if _, ok := held[stream]; !ok {
+			continue
+		}
+
+		delete(held, stream)
+		removed = append(removed, stream)
+	}
+	m.release(removed)
+	rest := sortedStreams(held)
+	m.mutex.Unlock()
+
+	return rest, m.unsubscribeReleased()
+}
+
+// release drops a reference to every stream and queues an unsubscribe of the
+// streams nobody holds anymore, must be called with the mutex held.
+func (m *SubscriptionManager) release(streams []MarketDataStream) {
+	for _, stream := range streams {
+		if m.decrement(stream) {
+			m.released[stream] = struct{}{}
+		}
+	}
+}
+
+// unsubscribeReleased sends the queued unsubscribe, the streams stay queued
+// if it fails. Must be called with the command mutex held.
+func (m *SubscriptionManager) unsubscribeReleased() error {
+	m.mutex.Lock()
+	streams := make([]MarketDataStream, 0, len(m.released))
+	for stream := range m.released {
+		streams = append(streams, stream)
+	}
+	m.mutex.Unlock()
+
+	if len(streams) == 0 {
+		return nil
+	}
+
+	_, err := m.handler.Send(commands.Unsubscribe(subscribeCommand(streams)))
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	for _, stream := range streams {
+		delete(m.released, stream)
+	}
+	m.mutex.Unlock()
+
+	return nil
+}
+
+// decrement returns true when the stream has no subscribers left, must be
+// called with the mutex held.
+func (m *SubscriptionManager) decrement(stream MarketDataStream) bool {
+	m.counts[stream]--
+	if m.counts[stream] > 0 {
+		return false
+	}
+
+	delete(m.counts, stream)
+	return true
+}
+
+func sortedStreams(set map[MarketDataStream]struct{}) []MarketDataStream {
+	streams := make([]MarketDataStream, 0, len(set))
+	for stream := range set {
+		streams = append(streams, stream)
+	}
+
+	sort.Slice(streams, func(i, j int) bool {
+		a, b := streams[i], streams[j]
+		if a.Security.Board != b.Security.Board {
+			return a.Security.Board < b.Security.Board
+		}
+		if a.Security.SecCode != b.Security.SecCode {
+			return a.Security.SecCode < b.Security.SecCode
+		}
+		return a.Kind < b.Kind
+	})
+
+	return streams
+}
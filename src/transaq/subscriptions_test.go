@@ -0,0 +1,305 @@
+package transaq
+
+import (
+	"context"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"sync"
+	"testing"
+	"time"
+)
+
+// commandsConnector is the simulator that counts the commands, rejects the
+// ones in reject and holds unsubscribe until hold is closed.
+type commandsConnector struct {
+	*SimulatorConnector
+	mutex  *sync.Mutex
+	sent   map[string]int
+	reject map[string]bool
+	hold   chan struct{}
+}
+
+func (c *commandsConnector) SendCommand(msg string) (string, uint64, error) {
+	id := commandId(msg)
+
+	c.mutex.Lock()
+	c.sent[id]++
+	reject := c.reject[id]
+	hold := c.hold
+	c.mutex.Unlock()
+
+	if reject {
+		return simulatorResultError("Rejected by the test"), 0, nil
+	}
+	if hold != nil && id == "unsubscribe" {
+		<-hold
+	}
+
+	return c.SimulatorConnector.SendCommand(msg)
+}
+
+func (c *commandsConnector) count(id string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.sent[id]
+}
+
+func (c *commandsConnector) rejecting(id string, reject bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.reject[id] = reject
+}
+
+func startSubscriptions(t *testing.T) (*commandsConnector, *SubscriptionManager) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	connector := &commandsConnector{
+		SimulatorConnector: NewSimulatorConnector(&logger),
+		mutex:              &sync.Mutex{},
+		sent:               map[string]int{},
+		reject:             map[string]bool{},
+	}
+	handler := NewTransaqHandler(&logger, connector, queue.NewLanedQueue[Message](1000, 1000), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		connector.Release()
+	})
+
+	err := handler.Init(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = handler.Send(testConnect("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, handler, ConnectionConnected)
+
+	return connector, NewSubscriptionManager(&logger, handler)
+}
+
+func quotationsOf(secCodes ...string) []MarketDataStream {
+	streams := make([]MarketDataStream, 0, len(secCodes))
+	for _, secCode := range secCodes {
+		streams = append(streams, MarketDataStream{
+			Kind:     MarketDataQuotations,
+			Security: commands.SecurityRef{Board: "TQBR", SecCode: secCode},
+		})
+	}
+
+	return streams
+}
+
+func expectQuotations(t *testing.T, connector *commandsConnector, want string) {
+	t.Helper()
+
+	if got := fmt.Sprint(connector.quotations()); got != want {
+		t.Fatalf("quotations of %s streamed, want %s", got, want)
+	}
+}
+
+func TestSubscriptionManagerSharesStreams(t *testing.T) {
+	connector, subscriptions := startSubscriptions(t)
+	subscriptions.Attach("first")
+	subscriptions.Attach("second")
+
+	held, err := subscriptions.Subscribe("first", quotationsOf("SBER", "GAZP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(held) != 2 {
+		t.Fatalf("first holds %v", held)
+	}
+
+	// only lkoh is new to Transaq
+	_, err = subscriptions.Subscribe("second", quotationsOf("SBER", "LKOH"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := connector.count("subscribe"); count != 2 {
+		t.Fatalf("subscribe is sent %d times, want 2", count)
+	}
+	expectQuotations(t, connector, "[1 2 3]")
+
+	// sber is still held by the second subscriber
+	held, err = subscriptions.Unsubscribe("first", quotationsOf("SBER", "GAZP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(held) != 0 {
+		t.Fatalf("first still holds %v", held)
+	}
+	expectQuotations(t, connector, "[1 3]")
+
+	_, err = subscriptions.Unsubscribe("second", quotationsOf("SBER"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectQuotations(t, connector, "[3]")
+
+	// not held, nothing is sent
+	_, err = subscriptions.Unsubscribe("first", quotationsOf("LKOH"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := connector.count("unsubscribe"); count != 2 {
+		t.Fatalf("unsubscribe is sent %d times, want 2", count)
+	}
+	expectQuotations(t, connector, "[3]")
+}
+
+func TestSubscriptionManagerDetachesOnStreamEnd(t *testing.T) {
+	connector, subscriptions := startSubscriptions(t)
+
+	// two data streams of the same subscriber
+	subscriptions.Attach("client")
+	subscriptions.Attach("client")
+	subscriptions.Attach("other")
+
+	_, err := subscriptions.Subscribe("client", quotationsOf("SBER", "GAZP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = subscriptions.Subscribe("other", quotationsOf("GAZP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscriptions.Detach("client")
+	expectQuotations(t, connector, "[1 2]")
+
+	// the last stream ends, gazp stays with the other subscriber
+	subscriptions.Detach("client")
+	expectQuotations(t, connector, "[2]")
+
+	_, err = subscriptions.Subscribe("client", quotationsOf("SBER"))
+	if err != ErrUnknownSubscriber {
+		t.Fatalf("got %v after the last stream ended, want %v", err, ErrUnknownSubscriber)
+	}
+
+	subscriptions.Detach("other")
+	expectQuotations(t, connector, "[]")
+}
+
+func TestSubscriptionManagerRetriesFailedUnsubscribe(t *testing.T) {
+	connector, subscriptions := startSubscriptions(t)
+	subscriptions.Attach("client")
+
+	_, err := subscriptions.Subscribe("client", quotationsOf("SBER", "GAZP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	connector.rejecting("unsubscribe", true)
+	held, err := subscriptions.Unsubscribe("client", quotationsOf("SBER"))
+	if err == nil {
+		t.Fatal("a rejected unsubscribe succeeded")
+	}
+	if fmt.Sprint(held) != fmt.Sprint(quotationsOf("GAZP")) {
+		t.Fatalf("client holds %v, want gazp", held)
+	}
+	expectQuotations(t, connector, "[1 2]")
+
+	// the next change sends the queued unsubscribe again
+	connector.rejecting("unsubscribe", false)
+	_, err = subscriptions.Subscribe("client", quotationsOf("LKOH"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectQuotations(t, connector, "[2 3]")
+}
+
+func TestSubscriptionManagerResubscribeCancelsQueuedUnsubscribe(t *testing.T) {
+	connector, subscriptions := startSubscriptions(t)
+	subscriptions.Attach("client")
+
+	_, err := subscriptions.Subscribe("client", quotationsOf("SBER"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	connector.rejecting("unsubscribe", true)
+	_, err = subscriptions.Unsubscribe("client", quotationsOf("SBER"))
+	if err == nil {
+		t.Fatal("a rejected unsubscribe succeeded")
+	}
+
+	connector.rejecting("unsubscribe", false)
+	_, err = subscriptions.Subscribe("client", quotationsOf("SBER"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := connector.count("unsubscribe"); count != 1 {
+		t.Fatalf("unsubscribe is sent %d times, want the rejected one only", count)
+	}
+	expectQuotations(t, connector, "[1]")
+}
+
+func TestSubscriptionManagerRollsBackFailedSubscribe(t *testing.T) {
+	connector, subscriptions := startSubscriptions(t)
+	subscriptions.Attach("client")
+
+	connector.rejecting("subscribe", true)
+	_, err := subscriptions.Subscribe("client", quotationsOf("SBER"))
+	if err == nil {
+		t.Fatal("a rejected subscribe succeeded")
+	}
+
+	// nothing is held, the next attempt reaches Transaq
+	connector.rejecting("subscribe", false)
+	held, err := subscriptions.Subscribe("client", quotationsOf("SBER"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(held) != 1 || connector.count("subscribe") != 2 {
+		t.Fatalf("client holds %v after %d subscribes", held, connector.count("subscribe"))
+	}
+	expectQuotations(t, connector, "[1]")
+}
+
+func TestSubscriptionManagerAttachDuringUnsubscribe(t *testing.T) {
+	connector, subscriptions := startSubscriptions(t)
+	subscriptions.Attach("client")
+
+	_, err := subscriptions.Subscribe("client", quotationsOf("SBER"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hold := make(chan struct{})
+	connector.mutex.Lock()
+	connector.hold = hold
+	connector.mutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		subscriptions.Detach("client")
+	}()
+	waitFor(t, "the unsubscribe", func() bool {
+		return connector.count("unsubscribe") == 1
+	})
+
+	// the connector is busy with the unsubscribe, a new stream is not held back
+	attached := make(chan struct{})
+	go func() {
+		defer close(attached)
+		subscriptions.Attach("other")
+	}()
+	select {
+	case <-attached:
+	case <-time.After(time.Second):
+		t.Fatal("attach waits for the connector")
+	}
+
+	close(hold)
+	<-done
+	expectQuotations(t, connector, "[]")
+}
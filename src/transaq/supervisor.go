@@ -0,0 +1,296 @@
+package transaq
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/rs/zerolog"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+type SupervisorConfig struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// part of the delay randomized in both directions, 0.2 means +-20%
+	Jitter float64
+	// how long to wait for server_status after a successful connect command
+	ConnectTimeout time.Duration
+}
+
+func DefaultSupervisorConfig() SupervisorConfig {
+	return SupervisorConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute * 2,
+		Multiplier:     2,
+		Jitter:         0.2,
+		ConnectTimeout: time.Minute,
+	}
+}
+
+type observedCommand struct {
+	XMLName xml.Name `xml:"command"`
+	Id      string   `xml:"id,attr"`
+}
+
+// Supervisor restores the broker session after Transaq drops it. It remembers
+// the last successful connect, reconnects with exponential backoff and has the
+// SubscriptionManager restore the subscriptions once the session is back. While
+// the connector reports recover="true" it is left to restore the session itself.
+// A connect that gets no server_status within ConnectTimeout is abandoned.
+type Supervisor struct {
+	mutex         *sync.Mutex
+	handler       *TransaqHandler
+	subscriptions *SubscriptionManager
+	config        SupervisorConfig
+	random        *rand.Rand
+	connect       *commands.Connect
+	// the session to host reached connected state, a connect to another host resets it
+	established bool
+	host        string
+	// the session was dropped by the server, not by a disconnect command
+	lost bool
+	// a reconnect command was sent, only connected or error tells its outcome
+	awaiting    bool
+	attempt     int
+	localLogger *zerolog.Logger
+}
+
+func NewSupervisor(
+	logger *zerolog.Logger,
+	handler *TransaqHandler,
+	subscriptions *SubscriptionManager,
+	config SupervisorConfig,
+) *Supervisor {
+	localLogger := logger.With().Str("Service", "Supervisor").Logger()
+
+	s := &Supervisor{
+		mutex:         &sync.Mutex{},
+		handler:       handler,
+		subscriptions: subscriptions,
+		config:        config,
+		random:        rand.New(rand.NewSource(time.Now().UnixNano())),
+		localLogger:   &localLogger,
+	}
+	handler.AddCommandObserver(s.observeCommand)
+
+	return s
+}
+
+func (s *Supervisor) Run(ctx context.Context) {
+	statuses := s.handler.WatchConnectionStatus(ctx)
+
+	timer := time.NewTimer(0)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	timerActive := false
+
+	schedule := func(delay time.Duration) {
+		if timerActive && !timer.Stop() {
+			<-timer.C
+		}
+		timer.Reset(delay)
+		timerActive = true
+	}
+	cancelTimer := func() {
+		if timerActive && !timer.Stop() {
+			<-timer.C
+		}
+		timerActive = false
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			cancelTimer()
+			return
+
+		case status, ok := <-statuses:
+			if !ok {
+				return
+			}
+
+			switch s.onStatus(status) {
+			case supervisorWait:
+				cancelTimer()
+			case supervisorReconnect:
+				if !timerActive {
+					schedule(s.nextDelay())
+				}
+			case supervisorRetry:
+				schedule(s.nextDelay())
+			}
+
+		case <-timer.C:
+			timerActive = false
+			schedule(s.reconnect())
+		}
+	}
+}
+
+type supervisorAction int
+
+const (
+	supervisorNothing supervisorAction = iota
+	supervisorWait
+	supervisorReconnect
+	supervisorRetry
+)
+
+func (s *Supervisor) onStatus(status ConnectionStatus) supervisorAction {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch status.State {
+	case ConnectionUninitialized, ConnectionConnecting, ConnectionDisconnecting:
+		// transitions caused by commands, the outcome comes with server_status
+		return supervisorNothing
+
+	case ConnectionConnected:
+		s.awaiting = false
+		if s.lost {
+			s.localLogger.Info().Msgf("Session restored after %d attempts", s.attempt)
+			go s.subscriptions.Restore()
+		}
+		s.established = true
+		s.lost = false
+		s.attempt = 0
+		return supervisorWait
+
+	case ConnectionRecovering:
+		s.localLogger.Info().Msg("Connector is recovering the session")
+		return supervisorWait
+
+	case ConnectionDisconnected, ConnectionError:
+		if s.connect == nil || !s.established {
+			return supervisorNothing
+		}
+
+		if !s.lost {
+			s.localLogger.Warn().Msgf("Session lost: %s %s", status.State, status.Error)
+		}
+		s.lost = true
+
+		// disconnected may come from the disconnect issued before reconnect,
+		// only an error tells that the attempt has failed
+		if s.awaiting && status.State == ConnectionError {
+			s.awaiting = false
+			return supervisorRetry
+		}
+		return supervisorReconnect
+	}
+
+	return supervisorNothing
+}
+
+// reconnect issues a connect command and returns the delay before the next attempt
+// in case server_status does not report the session as connected.
+func (s *Supervisor) reconnect() time.Duration {
+	s.mutex.Lock()
+	if !s.lost || s.connect == nil {
+		s.mutex.Unlock()
+		return s.config.ConnectTimeout
+	}
+
+	s.attempt++
+	attempt := s.attempt
+	connect := *s.connect
+	// the error of the attempt may come before the command answer
+	s.awaiting = true
+	s.mutex.Unlock()
+
+	s.localLogger.Info().Msgf("Reconnect attempt %d to %s:%d", attempt, connect.Host, connect.Port)
+
+	switch s.handler.ConnectionStatus().State {
+	case ConnectionError:
+		// the connector has to be disconnected before it accepts connect after an error
+		_, err := s.handler.send(commands.Disconnect{}, false)
+		if err != nil {
+			s.localLogger.Warn().Err(err).Msg("Disconnect before reconnect failed")
+		}
+
+	case ConnectionConnecting, ConnectionDisconnecting:
+		// the previous attempt got no server_status within ConnectTimeout, connect
+		// is rejected until the session is disconnected
+		s.localLogger.Warn().Msgf("No server_status within %s, abandoning the attempt", s.config.ConnectTimeout)
+		_, err := s.handler.send(commands.Disconnect{}, false)
+		if err != nil {
+			s.localLogger.Warn().Err(err).Msg("Disconnect of the abandoned attempt failed")
+		}
+		s.handler.abandonConnect("no server_status after connect")
+	}
+
+	_, err := s.handler.send(connect, false)
+	if err != nil {
+		s.localLogger.Error().Err(err).Msgf("Reconnect attempt %d failed", attempt)
+
+		s.mutex.Lock()
+		s.awaiting = false
+		s.mutex.Unlock()
+
+		return s.nextDelay()
+	}
+
+	return s.config.ConnectTimeout
+}
+
+func (s *Supervisor) nextDelay() time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delay := float64(s.config.InitialBackoff) * math.Pow(s.config.Multiplier, float64(s.attempt))
+	if delay > float64(s.config.MaxBackoff) {
+		delay = float64(s.config.MaxBackoff)
+	}
+
+	delay += delay * s.config.Jitter * (s.random.Float64()*2 - 1)
+	if delay < 0 {
+		delay = 0
+	}
+
+	return time.Duration(delay)
+}
+
+func (s *Supervisor) observeCommand(command string, result *commands.Result) {
+	if !result.Success {
+		return
+	}
+
+	observed := observedCommand{}
+	err := xml.Unmarshal([]byte(command), &observed)
+	if err != nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch observed.Id {
+	case "connect":
+		connect := commands.Connect{}
+		err = xml.Unmarshal([]byte(command), &connect)
+		if err != nil {
+			s.localLogger.Warn().Err(err).Msg("Connect parameters can not be remembered")
+			return
+		}
+		host := fmt.Sprintf("%s:%d", connect.Host, connect.Port)
+		if host != s.host {
+			s.established = false
+			s.host = host
+		}
+		s.connect = &connect
+		s.lost = false
+		s.awaiting = false
+
+	case "disconnect":
+		// disconnect requested by a client, do not bring the session back
+		s.connect = nil
+		s.lost = false
+		s.awaiting = false
+	}
+}
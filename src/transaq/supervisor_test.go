@@ -0,0 +1,265 @@
+package transaq
+
+import (
+	"context"
+	"github.com/TrueGameover/transaq-grpc/src/commands"
+	"github.com/TrueGameover/transaq-grpc/src/queue"
+	"github.com/rs/zerolog"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyConnector is the simulator whose connect attempts fail with a
+// connection error until failures run out, or are accepted and never answered
+// with server_status until silent ones run out. Disconnects are not answered
+// either while silent connects remain.
+type flakyConnector struct {
+	*SimulatorConnector
+	mutex    *sync.Mutex
+	failures int
+	silent   int
+	connects int
+}
+
+func (c *flakyConnector) SendCommand(msg string) (string, uint64, error) {
+	if commandId(msg) == "disconnect" {
+		c.mutex.Lock()
+		silent := c.silent > 0
+		c.mutex.Unlock()
+
+		if silent {
+			c.SimulatorConnector.mutex.Lock()
+			c.SimulatorConnector.connected = false
+			c.SimulatorConnector.subscriptions = map[int]*simulatorSubscription{}
+			c.SimulatorConnector.mutex.Unlock()
+			return simulatorResultSuccess(), 0, nil
+		}
+	}
+
+	if commandId(msg) == "connect" {
+		c.mutex.Lock()
+		c.connects++
+		fail := c.failures > 0
+		if fail {
+			c.failures--
+		}
+		silent := !fail && c.silent > 0
+		if silent {
+			c.silent--
+		}
+		c.mutex.Unlock()
+
+		if fail {
+			c.SimulatorConnector.emit(`<server_status connected="error">Connection refused</server_status>`)
+			return simulatorResultSuccess(), 0, nil
+		}
+		if silent {
+			return simulatorResultSuccess(), 0, nil
+		}
+	}
+
+	return c.SimulatorConnector.SendCommand(msg)
+}
+
+func (c *flakyConnector) fail(failures int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.failures = failures
+}
+
+func (c *flakyConnector) stayStill(silent int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.silent = silent
+}
+
+// quotations returns the securities the simulator streams quotations of.
+func (c *SimulatorConnector) quotations() []int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var secIds []int
+	for secId, subscription := range c.subscriptions {
+		if subscription.quotations {
+			secIds = append(secIds, secId)
+		}
+	}
+	sort.Ints(secIds)
+
+	return secIds
+}
+
+func (c *flakyConnector) connectsCount() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.connects
+}
+
+func startSupervisor(t *testing.T, connectTimeout time.Duration) (*TransaqHandler, *flakyConnector, *SubscriptionManager) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	connector := &flakyConnector{SimulatorConnector: NewSimulatorConnector(&logger), mutex: &sync.Mutex{}}
+	handler := NewTransaqHandler(&logger, connector, queue.NewLanedQueue[Message](1000, 1000), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		connector.Release()
+	})
+
+	err := handler.Init(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscriptions := NewSubscriptionManager(&logger, handler)
+	go subscriptions.Run(ctx)
+	supervisor := NewSupervisor(&logger, handler, subscriptions, SupervisorConfig{
+		InitialBackoff: time.Millisecond * 20,
+		MaxBackoff:     time.Millisecond * 200,
+		Multiplier:     2,
+		ConnectTimeout: connectTimeout,
+	})
+	go supervisor.Run(ctx)
+
+	return handler, connector, subscriptions
+}
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second * 3)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s is not reached", what)
+		}
+		time.Sleep(time.Millisecond * 5)
+	}
+}
+
+func waitState(t *testing.T, handler *TransaqHandler, state ConnectionState) {
+	t.Helper()
+
+	waitFor(t, "state "+state.String(), func() bool {
+		return handler.ConnectionStatus().State == state
+	})
+}
+
+func testConnect(host string) commands.Connect {
+	return commands.Connect{Login: "login", Password: "password", Host: host, Port: 3900}
+}
+
+func TestSupervisorRetriesFailedReconnects(t *testing.T) {
+	// far beyond the test, only the backoff may bring the session back
+	handler, connector, _ := startSupervisor(t, time.Minute)
+
+	_, err := handler.Send(testConnect("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, handler, ConnectionConnected)
+
+	// every failed attempt is preceded by the disconnected status of the
+	// disconnect issued after the error
+	connector.fail(2)
+	connector.emit(`<server_status connected="error">Connection lost</server_status>`)
+
+	waitFor(t, "the third reconnect", func() bool {
+		return connector.connectsCount() == 4
+	})
+	waitState(t, handler, ConnectionConnected)
+}
+
+func TestSupervisorIgnoresNeverEstablishedHost(t *testing.T) {
+	handler, connector, _ := startSupervisor(t, time.Minute)
+
+	_, err := handler.Send(testConnect("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, handler, ConnectionConnected)
+
+	_, err = handler.Send(commands.Disconnect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, handler, ConnectionDisconnected)
+
+	connector.fail(1)
+	_, err = handler.Send(testConnect("second"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, handler, ConnectionError)
+
+	time.Sleep(time.Millisecond * 200)
+	if connects := connector.connectsCount(); connects != 2 {
+		t.Fatalf("connect is sent %d times, want 2", connects)
+	}
+}
+
+func TestSupervisorAbandonsUnansweredConnect(t *testing.T) {
+	handler, connector, _ := startSupervisor(t, time.Millisecond*100)
+
+	_, err := handler.Send(testConnect("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, handler, ConnectionConnected)
+
+	// the first reconnect is accepted and never answered, the handler stays in
+	// connecting where every connect is rejected
+	connector.stayStill(1)
+	connector.emit(`<server_status connected="error">Connection lost</server_status>`)
+	waitFor(t, "the unanswered reconnect", func() bool {
+		return connector.connectsCount() == 2
+	})
+
+	waitState(t, handler, ConnectionConnected)
+	if connects := connector.connectsCount(); connects != 3 {
+		t.Fatalf("connect is sent %d times, want 3", connects)
+	}
+}
+
+func TestSupervisorRestoresManagedSubscriptions(t *testing.T) {
+	handler, connector, subscriptions := startSupervisor(t, time.Minute)
+
+	_, err := handler.Send(testConnect("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitState(t, handler, ConnectionConnected)
+
+	subscriptions.Attach("client")
+	defer subscriptions.Detach("client")
+	sber := MarketDataStream{Kind: MarketDataQuotations, Security: commands.SecurityRef{Board: "TQBR", SecCode: "SBER"}}
+	gazp := MarketDataStream{Kind: MarketDataQuotations, Security: commands.SecurityRef{Board: "TQBR", SecCode: "GAZP"}}
+	_, err = subscriptions.Subscribe("client", []MarketDataStream{sber, gazp})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the client leaves gazp while the session is down, the unsubscribe fails
+	connector.fail(3)
+	connector.emit(`<server_status connected="error">Connection lost</server_status>`)
+	waitState(t, handler, ConnectionError)
+	_, err = subscriptions.Unsubscribe("client", []MarketDataStream{gazp})
+	if err == nil {
+		t.Fatal("unsubscribe succeeded while the session is down")
+	}
+
+	waitFor(t, "sber only restored", func() bool {
+		quotations := connector.quotations()
+		return len(quotations) == 1 && quotations[0] == 1
+	})
+	time.Sleep(time.Millisecond * 50)
+	if quotations := connector.quotations(); len(quotations) != 1 {
+		t.Fatalf("quotations of %v restored, want sber only", quotations)
+	}
+}